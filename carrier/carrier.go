@@ -12,6 +12,7 @@ package carrier
 
 import (
 	"context"
+	"encoding/hex"
 	"math/big"
 	"sync"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
@@ -44,6 +46,10 @@ type Carrier interface {
 	Candidates(uint64, *big.Int, uint8) (*big.Int, []*types.Candidate, error)
 	// Votes returns the votes on height
 	Votes(uint64, *big.Int, uint8) (*big.Int, []*types.Vote, error)
+	// BucketIndexes returns the indexes of the active buckets on height
+	BucketIndexes(uint64, *big.Int, uint32) (*big.Int, []*big.Int, error)
+	// Endpoint returns the url of the client in use
+	Endpoint() string
 	// Close closes carrier
 	Close()
 }
@@ -51,7 +57,8 @@ type Carrier interface {
 // EthClientPool defines a set of ethereum clients with execute interface
 type EthClientPool struct {
 	clientURLs []string
-	client     *ethclient.Client
+	client     *rpc.Client
+	clientURL  string
 	lock       sync.RWMutex
 }
 
@@ -65,10 +72,17 @@ func NewEthClientPool(urls []string) *EthClientPool {
 
 // Close closes the current client if available
 func (pool *EthClientPool) Close() {
-	pool.swapClient(nil)
+	pool.swapClient(nil, "")
 }
 
-func (pool *EthClientPool) swapClient(client *ethclient.Client) {
+// Endpoint returns the url of the current client, or an empty string if none is connected
+func (pool *EthClientPool) Endpoint() string {
+	pool.lock.RLock()
+	defer pool.lock.RUnlock()
+	return pool.clientURL
+}
+
+func (pool *EthClientPool) swapClient(client *rpc.Client, url string) {
 	pool.lock.Lock()
 	defer pool.lock.Unlock()
 	if pool.client != client {
@@ -76,10 +90,11 @@ func (pool *EthClientPool) swapClient(client *ethclient.Client) {
 			pool.client.Close()
 		}
 		pool.client = client
+		pool.clientURL = url
 	}
 }
 
-func (pool *EthClientPool) execute(callback func(c *ethclient.Client) error, client *ethclient.Client) error {
+func (pool *EthClientPool) execute(callback func(c *rpc.Client) error, client *rpc.Client) error {
 	pool.lock.RLock()
 	defer pool.lock.RUnlock()
 	if client != nil {
@@ -92,13 +107,20 @@ func (pool *EthClientPool) execute(callback func(c *ethclient.Client) error, cli
 }
 
 // Execute executes callback by rotating all client urls
-func (pool *EthClientPool) Execute(callback func(c *ethclient.Client) error) (err error) {
+func (pool *EthClientPool) Execute(callback func(c *ethclient.Client) error) error {
+	return pool.ExecuteRPC(func(c *rpc.Client) error {
+		return callback(ethclient.NewClient(c))
+	})
+}
+
+// ExecuteRPC executes callback with raw rpc client by rotating all client urls
+func (pool *EthClientPool) ExecuteRPC(callback func(c *rpc.Client) error) (err error) {
 	if err = pool.execute(callback, nil); err == nil {
 		return
 	}
-	var client *ethclient.Client
+	var client *rpc.Client
 	for i := 0; i < len(pool.clientURLs); i++ {
-		if client, err = ethclient.Dial(pool.clientURLs[i]); err != nil {
+		if client, err = rpc.Dial(pool.clientURLs[i]); err != nil {
 			zap.L().Error(
 				"client is not reachable",
 				zap.String("url", pool.clientURLs[i]),
@@ -107,9 +129,10 @@ func (pool *EthClientPool) Execute(callback func(c *ethclient.Client) error) (er
 			continue
 		}
 		if err = pool.execute(callback, client); err == nil {
-			pool.swapClient(client)
+			pool.swapClient(client, pool.clientURLs[i])
 			return
 		}
+		client.Close()
 	}
 	return errors.Wrap(err, "failed to execute callback with any client")
 }
@@ -118,6 +141,7 @@ type ethereumCarrier struct {
 	ethClientPool           *EthClientPool
 	stakingContractAddress  common.Address
 	registerContractAddress common.Address
+	verifier                *stateVerifier
 }
 
 // NewEthereumVoteCarrier defines a carrier to fetch votes from ethereum contract
@@ -125,19 +149,51 @@ func NewEthereumVoteCarrier(
 	clientURLs []string,
 	registerContractAddress common.Address,
 	stakingContractAddress common.Address,
+) (Carrier, error) {
+	return NewVerifiableEthereumVoteCarrier(
+		clientURLs,
+		registerContractAddress,
+		stakingContractAddress,
+		VerifierConfig{},
+	)
+}
+
+// NewVerifiableEthereumVoteCarrier defines a carrier to fetch votes from ethereum contract, which
+// verifies the candidates and buckets against the state root of the block if enabled
+func NewVerifiableEthereumVoteCarrier(
+	clientURLs []string,
+	registerContractAddress common.Address,
+	stakingContractAddress common.Address,
+	verifierCfg VerifierConfig,
 ) (Carrier, error) {
 	if len(clientURLs) == 0 {
 		return nil, errors.New("client URL list is empty")
 	}
+	pool := NewEthClientPool(clientURLs)
+	var verifier *stateVerifier
+	if verifierCfg.Enabled {
+		var err error
+		if verifier, err = newStateVerifier(pool, verifierCfg); err != nil {
+			return nil, err
+		}
+	}
 	return &ethereumCarrier{
-		ethClientPool:           NewEthClientPool(clientURLs),
+		ethClientPool:           pool,
 		stakingContractAddress:  stakingContractAddress,
 		registerContractAddress: registerContractAddress,
+		verifier:                verifier,
 	}, nil
 }
 
+func (evc *ethereumCarrier) Endpoint() string {
+	return evc.ethClientPool.Endpoint()
+}
+
 func (evc *ethereumCarrier) Close() {
 	evc.ethClientPool.Close()
+	if evc.verifier != nil {
+		evc.verifier.Close()
+	}
 }
 
 func (evc *ethereumCarrier) BlockTimestamp(height uint64) (ts time.Time, err error) {
@@ -255,6 +311,21 @@ func (evc *ethereumCarrier) Candidates(
 	if len(retval.Addresses) != num {
 		return nil, nil, errors.New("invalid addresses from GetAllCandidates")
 	}
+	if evc.verifier != nil {
+		if err := evc.verifier.verifyCandidates(
+			height,
+			evc.registerContractAddress,
+			startIndex,
+			int(count),
+			retval.Names,
+			retval.Addresses,
+			retval.IoOperatorAddr,
+			retval.IoRewardAddr,
+			retval.Weights,
+		); err != nil {
+			return nil, nil, err
+		}
+	}
 	operatorPubKeys, err := decodeAddress(retval.IoOperatorAddr, num)
 	if err != nil {
 		return nil, nil, err
@@ -272,6 +343,14 @@ func (evc *ethereumCarrier) Candidates(
 			rewardPubKeys[i],
 			retval.Weights[i].Uint64(),
 		)
+		if err := candidates[i].MalformedAddresses(); err != nil {
+			zap.L().Warn(
+				"malformed candidate registration",
+				zap.Uint64("height", height),
+				zap.String("name", hex.EncodeToString(retval.Names[i][:])),
+				zap.Error(err),
+			)
+		}
 	}
 	return new(big.Int).Add(startIndex, big.NewInt(int64(num))), candidates, nil
 }
@@ -288,6 +367,29 @@ type EthereumBucketsResult struct {
 	Owners          []common.Address
 }
 
+// num returns the number of buckets in the result, excluding the paddings
+func (result EthereumBucketsResult) num() int {
+	return numOfIndexes(result.Count, result.Indexes)
+}
+
+// numOfIndexes returns the number of the leading bucket indexes returned by the staking contract,
+// excluding the paddings
+func numOfIndexes(count *big.Int, indexes []*big.Int) int {
+	if count == nil || !count.IsInt64() {
+		return 0
+	}
+	num := int(count.Int64())
+	if num > len(indexes) {
+		num = len(indexes)
+	}
+	for i, index := range indexes[:num] {
+		if big.NewInt(0).Cmp(index) == 0 { // back to start
+			return i
+		}
+	}
+	return num
+}
+
 func (evc *ethereumCarrier) buckets(
 	opts *bind.CallOpts,
 	previousIndex *big.Int,
@@ -339,14 +441,19 @@ func (evc *ethereumCarrier) Votes(
 	if err != nil {
 		return nil, nil, err
 	}
-	votes := []*types.Vote{}
-	if buckets.Count == nil || buckets.Count.Cmp(big.NewInt(0)) == 0 || len(buckets.Indexes) == 0 {
-		return previousIndex, votes, nil
-	}
-	for i, index := range buckets.Indexes {
-		if big.NewInt(0).Cmp(index) == 0 { // back to start, this is a redundant condition
-			break
+	if evc.verifier != nil {
+		if err := evc.verifier.verifyBuckets(
+			height,
+			evc.stakingContractAddress,
+			previousIndex,
+			int(count),
+			buckets,
+		); err != nil {
+			return nil, nil, err
 		}
+	}
+	votes := []*types.Vote{}
+	for i, index := range buckets.Indexes[:buckets.num()] {
 		v, err := types.NewVote(
 			time.Unix(buckets.StakeStartTimes[i].Int64(), 0),
 			time.Duration(buckets.StakeDurations[i].Uint64()*24)*time.Hour,
@@ -368,6 +475,55 @@ func (evc *ethereumCarrier) Votes(
 	return previousIndex, votes, nil
 }
 
+func (evc *ethereumCarrier) BucketIndexes(
+	height uint64,
+	previousIndex *big.Int,
+	count uint32,
+) (*big.Int, []*big.Int, error) {
+	if previousIndex == nil || previousIndex.Cmp(big.NewInt(0)) < 0 {
+		previousIndex = big.NewInt(0)
+	}
+	opts := &bind.CallOpts{BlockNumber: new(big.Int).SetUint64(height)}
+	var result struct {
+		Count   *big.Int
+		Indexes []*big.Int
+	}
+	if err := evc.ethClientPool.Execute(func(client *ethclient.Client) error {
+		caller, err := contract.NewStakingCaller(evc.stakingContractAddress, client)
+		if err != nil {
+			return err
+		}
+		bucket, err := caller.Buckets(opts, previousIndex)
+		if err != nil {
+			return err
+		}
+		if bucket.Next.Cmp(big.NewInt(0)) <= 0 {
+			return nil
+		}
+		result, err = caller.GetActiveBucketIdx(opts, previousIndex, new(big.Int).SetUint64(uint64(count)))
+		return err
+	}); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get bucket indexes")
+	}
+	indexes := result.Indexes[:numOfIndexes(result.Count, result.Indexes)]
+	if evc.verifier != nil {
+		if err := evc.verifier.verifyBucketIndexes(
+			height,
+			evc.stakingContractAddress,
+			previousIndex,
+			int(count),
+			indexes,
+		); err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(indexes) > 0 {
+		previousIndex = indexes[len(indexes)-1]
+	}
+
+	return previousIndex, indexes, nil
+}
+
 func decodeAddress(data [][32]byte, num int) ([][]byte, error) {
 	if len(data) != 2*num {
 		return nil, errors.New("the length of address array is not as expected")
@@ -0,0 +1,616 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package carrier
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// ErrUnverifiable indicates that the data read from gravity chain does not match the state proof
+var ErrUnverifiable = errors.New("data does not match the state proof")
+
+// Storage layout of the staking contract, in which a bucket is stored in a mapping from index to
+//   struct { bytes12 canName; uint256 stakedAmount; uint256 stakeDuration; uint256 stakeStartTime;
+//            bool nonDecay; uint256 unstakeStartTime; address bucketOwner; uint256 createTime;
+//            uint256 prev; uint256 next; }
+// in which the buckets form a linked list from the sentinel bucket 0 by next, with the unstaked ones
+// skipped by getActiveBuckets, and of the register contract, in which a candidate is stored in an array
+// of
+//   struct { bytes12 name; address addr; string ioOperatorAddr; string ioRewardAddr; uint256 weight; }
+// whose length is candidateCount and is stored in the slot of the array
+const (
+	bucketCanNameOffset        = 0
+	bucketStakedAmountOffset   = 1
+	bucketStakeDurationOffset  = 2
+	bucketStakeStartTimeOffset = 3
+	bucketNonDecayOffset       = 4
+	bucketUnstakeStartOffset   = 5
+	bucketOwnerOffset          = 6
+	bucketNextOffset           = 9
+
+	candidateNameAndAddrOffset  = 0
+	candidateOperatorAddrOffset = 1
+	candidateRewardAddrOffset   = 2
+	candidateWeightOffset       = 3
+	candidateSlotSize           = 4
+
+	// stateRootCacheSize is the number of heights whose verified state roots are cached
+	stateRootCacheSize = 64
+)
+
+// VerifierConfig defines the config of verifying the buckets and candidates read from gravity chain
+// with the merkle patricia proofs against the state root of the block
+type VerifierConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// StateRootAPIs are the endpoints to cross check the state root of a block, at least one of which
+	// is required, since the state root returned by the main endpoint cannot be trusted by itself
+	StateRootAPIs []string `yaml:"stateRootAPIs"`
+	// StateRootQuorum is the number of endpoints in StateRootAPIs that must return the same state
+	// root as the main endpoint. All of the endpoints are required if it is 0.
+	StateRootQuorum int `yaml:"stateRootQuorum"`
+	// BucketsSlot is the storage slot of the buckets mapping in staking contract, which is required
+	// since it depends on the compiled contract
+	BucketsSlot *uint64 `yaml:"bucketsSlot"`
+	// CandidatesSlot is the storage slot of the candidates array in register contract, which is
+	// required since it depends on the compiled contract
+	CandidatesSlot *uint64 `yaml:"candidatesSlot"`
+}
+
+type storageProof struct {
+	Key   string       `json:"key"`
+	Value *hexutil.Big `json:"value"`
+	Proof []string     `json:"proof"`
+}
+
+type accountProof struct {
+	Address      common.Address `json:"address"`
+	AccountProof []string       `json:"accountProof"`
+	StorageHash  common.Hash    `json:"storageHash"`
+	StorageProof []storageProof `json:"storageProof"`
+}
+
+// account is the consensus representation of an account in the state trie
+type account struct {
+	Nonce    uint64
+	Balance  *big.Int
+	Root     common.Hash
+	CodeHash []byte
+}
+
+type stateVerifier struct {
+	ethClientPool   *EthClientPool
+	crossCheckPools []*EthClientPool
+	quorum          int
+	bucketsSlot     common.Hash
+	candidatesSlot  common.Hash
+	// roots caches the cross checked state roots by height
+	roots      map[uint64]common.Hash
+	rootsMutex sync.Mutex
+}
+
+func newStateVerifier(pool *EthClientPool, cfg VerifierConfig) (*stateVerifier, error) {
+	if len(cfg.StateRootAPIs) == 0 {
+		return nil, errors.New("at least one endpoint is required to cross check the state root")
+	}
+	if cfg.BucketsSlot == nil || cfg.CandidatesSlot == nil {
+		return nil, errors.New("the storage slots of buckets and candidates are required")
+	}
+	quorum := cfg.StateRootQuorum
+	if quorum == 0 {
+		quorum = len(cfg.StateRootAPIs)
+	}
+	if quorum < 1 {
+		return nil, errors.Errorf("invalid state root quorum %d", quorum)
+	}
+	if quorum > len(cfg.StateRootAPIs) {
+		return nil, errors.Errorf(
+			"state root quorum %d is larger than the number of endpoints %d",
+			quorum,
+			len(cfg.StateRootAPIs),
+		)
+	}
+	crossCheckPools := make([]*EthClientPool, len(cfg.StateRootAPIs))
+	for i, url := range cfg.StateRootAPIs {
+		crossCheckPools[i] = NewEthClientPool([]string{url})
+	}
+	return &stateVerifier{
+		ethClientPool:   pool,
+		crossCheckPools: crossCheckPools,
+		quorum:          quorum,
+		bucketsSlot:     common.BigToHash(new(big.Int).SetUint64(*cfg.BucketsSlot)),
+		candidatesSlot:  common.BigToHash(new(big.Int).SetUint64(*cfg.CandidatesSlot)),
+		roots:           map[uint64]common.Hash{},
+	}, nil
+}
+
+func (v *stateVerifier) Close() {
+	for _, pool := range v.crossCheckPools {
+		pool.Close()
+	}
+}
+
+// stateRoot returns the state root of a block, which has been cross checked with other endpoints
+func (v *stateVerifier) stateRoot(height uint64) (common.Hash, error) {
+	v.rootsMutex.Lock()
+	root, ok := v.roots[height]
+	v.rootsMutex.Unlock()
+	if ok {
+		return root, nil
+	}
+	root, err := v.crossCheckedStateRoot(height)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	v.rootsMutex.Lock()
+	defer v.rootsMutex.Unlock()
+	if len(v.roots) >= stateRootCacheSize {
+		// the heights are fetched in order, so the lowest one is the least likely to be read again
+		lowest := height
+		for h := range v.roots {
+			if h < lowest {
+				lowest = h
+			}
+		}
+		delete(v.roots, lowest)
+	}
+	v.roots[height] = root
+	return root, nil
+}
+
+// crossCheckedStateRoot reads the state root of a block from the main endpoint and cross checks it
+// with the other endpoints
+func (v *stateVerifier) crossCheckedStateRoot(height uint64) (root common.Hash, err error) {
+	number := new(big.Int).SetUint64(height)
+	if err = v.ethClientPool.Execute(func(client *ethclient.Client) error {
+		header, err := client.HeaderByNumber(context.Background(), number)
+		if err == nil {
+			root = header.Root
+		}
+		return err
+	}); err != nil {
+		return
+	}
+	agreed := 0
+	for i, pool := range v.crossCheckPools {
+		var crossCheckRoot common.Hash
+		if err := pool.Execute(func(client *ethclient.Client) error {
+			header, err := client.HeaderByNumber(context.Background(), number)
+			if err == nil {
+				crossCheckRoot = header.Root
+			}
+			return err
+		}); err != nil {
+			zap.L().Warn("failed to cross check state root", zap.Int("endpoint", i), zap.Error(err))
+			continue
+		}
+		if crossCheckRoot != root {
+			return common.Hash{}, errors.Wrapf(
+				ErrUnverifiable,
+				"state root %x of height %d is different from %x returned by endpoint %d",
+				root,
+				height,
+				crossCheckRoot,
+				i,
+			)
+		}
+		agreed++
+	}
+	if agreed < v.quorum {
+		return common.Hash{}, errors.Errorf(
+			"only %d endpoints confirmed the state root of height %d, %d required",
+			agreed,
+			height,
+			v.quorum,
+		)
+	}
+	return
+}
+
+// slotReader reads the values of storage slots, which have been verified against a state root
+type slotReader func(slots []common.Hash) (map[common.Hash]common.Hash, error)
+
+// reader returns the slot reader of the storage of a contract on a height, which is verified against
+// the cross checked state root of the block
+func (v *stateVerifier) reader(height uint64, contract common.Address) (slotReader, error) {
+	root, err := v.stateRoot(height)
+	if err != nil {
+		return nil, err
+	}
+	return func(slots []common.Hash) (map[common.Hash]common.Hash, error) {
+		return v.storage(root, height, contract, slots)
+	}, nil
+}
+
+// storage returns the values of the storage slots of a contract on a height, which have been
+// verified against the state root
+func (v *stateVerifier) storage(
+	root common.Hash,
+	height uint64,
+	contract common.Address,
+	slots []common.Hash,
+) (map[common.Hash]common.Hash, error) {
+	keys := make([]string, len(slots))
+	for i, slot := range slots {
+		keys[i] = slot.Hex()
+	}
+	var proof accountProof
+	if err := v.ethClientPool.ExecuteRPC(func(client *rpc.Client) error {
+		return client.CallContext(
+			context.Background(),
+			&proof,
+			"eth_getProof",
+			contract,
+			keys,
+			hexutil.EncodeUint64(height),
+		)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to get state proof")
+	}
+	value, err := verifyProof(root, crypto.Keccak256(contract.Bytes()), proof.AccountProof)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid account proof of %s", contract.Hex())
+	}
+	var acc account
+	if err := rlp.DecodeBytes(value, &acc); err != nil {
+		return nil, errors.Wrapf(err, "invalid account of %s", contract.Hex())
+	}
+	if acc.Root != proof.StorageHash {
+		return nil, errors.Wrapf(ErrUnverifiable, "storage hash of %s does not match", contract.Hex())
+	}
+	if len(proof.StorageProof) != len(slots) {
+		return nil, errors.Wrapf(ErrUnverifiable, "missing storage proofs of %s", contract.Hex())
+	}
+	values := make(map[common.Hash]common.Hash, len(slots))
+	for i, sp := range proof.StorageProof {
+		value, err := verifyProof(acc.Root, crypto.Keccak256(slots[i].Bytes()), sp.Proof)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid storage proof of slot %s", slots[i].Hex())
+		}
+		var word []byte
+		if len(value) > 0 {
+			if err := rlp.DecodeBytes(value, &word); err != nil {
+				return nil, errors.Wrapf(err, "invalid storage value of slot %s", slots[i].Hex())
+			}
+		}
+		values[slots[i]] = common.BytesToHash(word)
+	}
+
+	return values, nil
+}
+
+// verifyBuckets verifies a page of buckets following the previous index, requested with limit
+func (v *stateVerifier) verifyBuckets(
+	height uint64,
+	contract common.Address,
+	previousIndex *big.Int,
+	limit int,
+	buckets EthereumBucketsResult,
+) error {
+	read, err := v.reader(height, contract)
+	if err != nil {
+		return err
+	}
+	indexes := buckets.Indexes[:buckets.num()]
+	values, err := v.walkBuckets(
+		read,
+		previousIndex,
+		limit,
+		indexes,
+		bucketCanNameOffset,
+		bucketStakedAmountOffset,
+		bucketStakeDurationOffset,
+		bucketStakeStartTimeOffset,
+		bucketNonDecayOffset,
+		bucketOwnerOffset,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "buckets after %d on height %d", previousIndex, height)
+	}
+	for i, index := range indexes {
+		base := mappingSlot(index, v.bucketsSlot)
+		nonDecay := values[offsetSlot(base, bucketNonDecayOffset)].Big().Sign() != 0
+		if !bytes.Equal(values[offsetSlot(base, bucketCanNameOffset)].Bytes()[20:], buckets.CanNames[i][:]) ||
+			values[offsetSlot(base, bucketStakedAmountOffset)].Big().Cmp(buckets.StakedAmounts[i]) != 0 ||
+			values[offsetSlot(base, bucketStakeDurationOffset)].Big().Cmp(buckets.StakeDurations[i]) != 0 ||
+			values[offsetSlot(base, bucketStakeStartTimeOffset)].Big().Cmp(buckets.StakeStartTimes[i]) != 0 ||
+			nonDecay == buckets.Decays[i] ||
+			common.BytesToAddress(values[offsetSlot(base, bucketOwnerOffset)].Bytes()) != buckets.Owners[i] {
+			return errors.Wrapf(ErrUnverifiable, "bucket %d on height %d", index, height)
+		}
+	}
+	return nil
+}
+
+// verifyBucketIndexes verifies a page of bucket indexes following the previous index, requested with
+// limit
+func (v *stateVerifier) verifyBucketIndexes(
+	height uint64,
+	contract common.Address,
+	previousIndex *big.Int,
+	limit int,
+	indexes []*big.Int,
+) error {
+	read, err := v.reader(height, contract)
+	if err != nil {
+		return err
+	}
+	if _, err := v.walkBuckets(read, previousIndex, limit, indexes); err != nil {
+		return errors.Wrapf(err, "bucket indexes after %d on height %d", previousIndex, height)
+	}
+	return nil
+}
+
+// walkBuckets follows the linked list of buckets from the previous index, and verifies that the
+// indexes are exactly the buckets next in the list which have not been unstaked, and that the list
+// ends after them if fewer than limit. The values of the slots at the offsets of the indexed buckets
+// are returned along.
+func (v *stateVerifier) walkBuckets(
+	read slotReader,
+	previousIndex *big.Int,
+	limit int,
+	indexes []*big.Int,
+	offsets ...int64,
+) (map[common.Hash]common.Hash, error) {
+	slots := []common.Hash{offsetSlot(mappingSlot(previousIndex, v.bucketsSlot), bucketNextOffset)}
+	for _, index := range indexes {
+		base := mappingSlot(index, v.bucketsSlot)
+		for _, offset := range offsets {
+			slots = append(slots, offsetSlot(base, offset))
+		}
+		slots = append(slots, offsetSlot(base, bucketUnstakeStartOffset), offsetSlot(base, bucketNextOffset))
+	}
+	values, err := read(slots)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.readSkippedBuckets(read, values, previousIndex, limit, indexes); err != nil {
+		return nil, err
+	}
+	current := previousIndex
+	visited := map[common.Hash]bool{}
+	i := 0
+	for i < len(indexes) || len(indexes) < limit {
+		next := values[offsetSlot(mappingSlot(current, v.bucketsSlot), bucketNextOffset)].Big()
+		if next.Sign() == 0 {
+			break
+		}
+		current = next
+		base := mappingSlot(next, v.bucketsSlot)
+		if visited[base] {
+			return nil, errors.Wrapf(ErrUnverifiable, "bucket %d is visited twice", next)
+		}
+		visited[base] = true
+		if i < len(indexes) && next.Cmp(indexes[i]) == 0 {
+			if values[offsetSlot(base, bucketUnstakeStartOffset)].Big().Sign() != 0 {
+				return nil, errors.Wrapf(ErrUnverifiable, "bucket %d has been unstaked", next)
+			}
+			i++
+			continue
+		}
+		// only the unstaked buckets could be skipped
+		if values[offsetSlot(base, bucketUnstakeStartOffset)].Big().Sign() == 0 {
+			return nil, errors.Wrapf(ErrUnverifiable, "active bucket %d is missing", next)
+		}
+	}
+	if i < len(indexes) {
+		return nil, errors.Wrapf(ErrUnverifiable, "bucket %d is not next in the list", indexes[i])
+	}
+	return values, nil
+}
+
+// readSkippedBuckets reads the slots of the buckets in between the indexed ones into values, which
+// are expected to be unstaked. The gaps are followed together, such that the first skipped buckets of
+// all the gaps are read in one batch, then the second ones, and so on. A gap is no longer followed
+// once it reaches a bucket read before or an active bucket, which is left to walkBuckets to check.
+func (v *stateVerifier) readSkippedBuckets(
+	read slotReader,
+	values map[common.Hash]common.Hash,
+	previousIndex *big.Int,
+	limit int,
+	indexes []*big.Int,
+) error {
+	// a gap starts from a bucket and ends before the next indexed bucket, or the end of the list
+	heads := append([]*big.Int{previousIndex}, indexes...)
+	ends := append(append([]*big.Int{}, indexes...), nil)
+	if len(indexes) >= limit {
+		heads = heads[:len(indexes)]
+		ends = ends[:len(indexes)]
+	}
+	isRead := func(index *big.Int) bool {
+		_, ok := values[offsetSlot(mappingSlot(index, v.bucketsSlot), bucketNextOffset)]
+		return ok
+	}
+	for {
+		skipped := make([]*big.Int, len(heads))
+		var slots []common.Hash
+		for g, head := range heads {
+			if head == nil {
+				continue
+			}
+			next := values[offsetSlot(mappingSlot(head, v.bucketsSlot), bucketNextOffset)].Big()
+			if next.Sign() == 0 || (ends[g] != nil && next.Cmp(ends[g]) == 0) || isRead(next) {
+				heads[g] = nil
+				continue
+			}
+			base := mappingSlot(next, v.bucketsSlot)
+			skipped[g] = next
+			slots = append(slots, offsetSlot(base, bucketUnstakeStartOffset), offsetSlot(base, bucketNextOffset))
+		}
+		if len(slots) == 0 {
+			return nil
+		}
+		batch, err := read(slots)
+		if err != nil {
+			return err
+		}
+		for slot, value := range batch {
+			values[slot] = value
+		}
+		for g, index := range skipped {
+			if index == nil {
+				continue
+			}
+			heads[g] = index
+			if values[offsetSlot(mappingSlot(index, v.bucketsSlot), bucketUnstakeStartOffset)].Big().Sign() == 0 {
+				heads[g] = nil
+			}
+		}
+	}
+}
+
+// verifyCandidates verifies a page of candidates from the start index, requested with limit
+func (v *stateVerifier) verifyCandidates(
+	height uint64,
+	contract common.Address,
+	startIndex *big.Int,
+	limit int,
+	names [][12]byte,
+	addresses []common.Address,
+	operatorAddrs [][32]byte,
+	rewardAddrs [][32]byte,
+	weights []*big.Int,
+) error {
+	arrayBase := common.BytesToHash(crypto.Keccak256(v.candidatesSlot.Bytes()))
+	bases := make([]common.Hash, len(names))
+	// the length of the array
+	slots := []common.Hash{v.candidatesSlot}
+	for i := range names {
+		index := new(big.Int).Add(startIndex, big.NewInt(int64(i)))
+		bases[i] = common.BigToHash(new(big.Int).Add(
+			arrayBase.Big(),
+			new(big.Int).Mul(index, big.NewInt(candidateSlotSize)),
+		))
+		slots = append(
+			slots,
+			offsetSlot(bases[i], candidateNameAndAddrOffset),
+			offsetSlot(bases[i], candidateWeightOffset),
+		)
+		for _, offset := range []int64{candidateOperatorAddrOffset, candidateRewardAddrOffset} {
+			stringSlot := offsetSlot(bases[i], offset)
+			dataSlot := common.BytesToHash(crypto.Keccak256(stringSlot.Bytes()))
+			slots = append(slots, stringSlot, offsetSlot(dataSlot, 0), offsetSlot(dataSlot, 1))
+		}
+	}
+	read, err := v.reader(height, contract)
+	if err != nil {
+		return err
+	}
+	values, err := read(slots)
+	if err != nil {
+		return err
+	}
+	expected := numOfCandidates(values[v.candidatesSlot].Big(), startIndex, limit)
+	if expected != len(names) {
+		return errors.Wrapf(
+			ErrUnverifiable,
+			"%d candidates from index %d on height %d, %d expected",
+			len(names),
+			startIndex,
+			height,
+			expected,
+		)
+	}
+	for i, base := range bases {
+		nameAndAddr := values[offsetSlot(base, candidateNameAndAddrOffset)].Bytes()
+		if !bytes.Equal(nameAndAddr[20:], names[i][:]) ||
+			common.BytesToAddress(nameAndAddr[:20]) != addresses[i] ||
+			values[offsetSlot(base, candidateWeightOffset)].Big().Cmp(weights[i]) != 0 ||
+			!matchString(values, offsetSlot(base, candidateOperatorAddrOffset), operatorAddrs[2*i], operatorAddrs[2*i+1]) ||
+			!matchString(values, offsetSlot(base, candidateRewardAddrOffset), rewardAddrs[2*i], rewardAddrs[2*i+1]) {
+			return errors.Wrapf(ErrUnverifiable, "candidate %x on height %d", names[i], height)
+		}
+	}
+	return nil
+}
+
+// numOfCandidates returns the number of candidates in the page from the start index, requested with
+// limit, of an array of length
+func numOfCandidates(length *big.Int, startIndex *big.Int, limit int) int {
+	num := new(big.Int).Sub(length, startIndex)
+	switch {
+	case num.Sign() < 0:
+		return 0
+	case num.Cmp(big.NewInt(int64(limit))) > 0:
+		return limit
+	default:
+		return int(num.Int64())
+	}
+}
+
+// matchString checks whether the first 64 bytes of a string in storage match the given words
+func matchString(values map[common.Hash]common.Hash, slot common.Hash, first [32]byte, second [32]byte) bool {
+	head := values[slot]
+	expected := append(first[:], second[:]...)
+	var data []byte
+	if head[31]%2 == 0 {
+		// a short string is stored in place with its length * 2 in the lowest byte
+		length := int(head[31] / 2)
+		data = head[:length]
+	} else {
+		dataSlot := common.BytesToHash(crypto.Keccak256(slot.Bytes()))
+		length := int(new(big.Int).Div(head.Big(), big.NewInt(2)).Int64())
+		if length > len(expected) {
+			length = len(expected)
+		}
+		words := append(values[offsetSlot(dataSlot, 0)].Bytes(), values[offsetSlot(dataSlot, 1)].Bytes()...)
+		data = words[:length]
+	}
+	if !bytes.Equal(data, expected[:len(data)]) {
+		return false
+	}
+	// the rest of the given words should be paddings
+	for _, b := range expected[len(data):] {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func mappingSlot(key *big.Int, slot common.Hash) common.Hash {
+	return common.BytesToHash(crypto.Keccak256(common.BigToHash(key).Bytes(), slot.Bytes()))
+}
+
+func offsetSlot(slot common.Hash, offset int64) common.Hash {
+	return common.BigToHash(new(big.Int).Add(slot.Big(), big.NewInt(offset)))
+}
+
+func verifyProof(root common.Hash, key []byte, proof []string) ([]byte, error) {
+	db := memorydb.New()
+	for _, node := range proof {
+		data, err := hexutil.Decode(node)
+		if err != nil {
+			return nil, err
+		}
+		if err := db.Put(crypto.Keccak256(data), data); err != nil {
+			return nil, err
+		}
+	}
+	value, _, err := trie.VerifyProof(root, key, db)
+	if err != nil {
+		return nil, errors.Wrap(ErrUnverifiable, err.Error())
+	}
+	return value, nil
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package carrier
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlots(t *testing.T) {
+	require := require.New(t)
+	slot := common.BigToHash(big.NewInt(3))
+	require.Equal(
+		common.BytesToHash(crypto.Keccak256(common.BigToHash(big.NewInt(7)).Bytes(), slot.Bytes())),
+		mappingSlot(big.NewInt(7), slot),
+	)
+	require.Equal(common.BigToHash(big.NewInt(9)), offsetSlot(slot, 6))
+}
+
+func TestMatchString(t *testing.T) {
+	require := require.New(t)
+	slot := common.BigToHash(big.NewInt(1))
+	var first, second [32]byte
+	t.Run("short string", func(t *testing.T) {
+		copy(first[:], "io1short")
+		var head common.Hash
+		copy(head[:], "io1short")
+		head[31] = byte(2 * len("io1short"))
+		values := map[common.Hash]common.Hash{slot: head}
+		require.True(matchString(values, slot, first, second))
+		first[0] = 'x'
+		require.False(matchString(values, slot, first, second))
+	})
+	t.Run("long string", func(t *testing.T) {
+		str := "io1qyqsyqcy6nm58gjd2wr035wz5eyd5uq47zyqpng3gxe7nh"
+		copy(first[:], str[:32])
+		copy(second[:], str[32:])
+		dataSlot := common.BytesToHash(crypto.Keccak256(slot.Bytes()))
+		var word0, word1 common.Hash
+		copy(word0[:], str[:32])
+		copy(word1[:], str[32:])
+		values := map[common.Hash]common.Hash{
+			slot:                    common.BigToHash(big.NewInt(int64(2*len(str) + 1))),
+			offsetSlot(dataSlot, 0): word0,
+			offsetSlot(dataSlot, 1): word1,
+		}
+		require.True(matchString(values, slot, first, second))
+		second[31] = 1
+		require.False(matchString(values, slot, first, second))
+	})
+}
+
+func TestNewStateVerifier(t *testing.T) {
+	require := require.New(t)
+	bucketsSlot, candidatesSlot := uint64(0), uint64(1)
+	_, err := newStateVerifier(nil, VerifierConfig{Enabled: true})
+	require.Error(err)
+	_, err = newStateVerifier(nil, VerifierConfig{Enabled: true, StateRootAPIs: []string{"a"}})
+	require.Error(err)
+	_, err = newStateVerifier(nil, VerifierConfig{
+		Enabled:         true,
+		StateRootAPIs:   []string{"a"},
+		StateRootQuorum: -1,
+		BucketsSlot:     &bucketsSlot,
+		CandidatesSlot:  &candidatesSlot,
+	})
+	require.Error(err)
+	_, err = newStateVerifier(nil, VerifierConfig{
+		Enabled:         true,
+		StateRootAPIs:   []string{"a"},
+		StateRootQuorum: 2,
+		BucketsSlot:     &bucketsSlot,
+		CandidatesSlot:  &candidatesSlot,
+	})
+	require.Error(err)
+	v, err := newStateVerifier(nil, VerifierConfig{
+		Enabled:        true,
+		StateRootAPIs:  []string{"a", "b"},
+		BucketsSlot:    &bucketsSlot,
+		CandidatesSlot: &candidatesSlot,
+	})
+	require.NoError(err)
+	require.Equal(2, v.quorum)
+	require.Equal(common.BigToHash(big.NewInt(1)), v.candidatesSlot)
+	v.Close()
+}
+
+func TestWalkBuckets(t *testing.T) {
+	require := require.New(t)
+	v := &stateVerifier{bucketsSlot: common.BigToHash(big.NewInt(2))}
+	// the list is 0 -> 1 -> 3 -> 4 -> 5 -> 6, in which 3 and 5 have been unstaked
+	storage := map[common.Hash]common.Hash{}
+	next := map[int64]int64{0: 1, 1: 3, 3: 4, 4: 5, 5: 6, 6: 0}
+	for index, n := range next {
+		base := mappingSlot(big.NewInt(index), v.bucketsSlot)
+		storage[offsetSlot(base, bucketNextOffset)] = common.BigToHash(big.NewInt(n))
+		storage[offsetSlot(base, bucketCanNameOffset)] = common.BigToHash(big.NewInt(100 + index))
+	}
+	for _, index := range []int64{3, 5} {
+		storage[offsetSlot(mappingSlot(big.NewInt(index), v.bucketsSlot), bucketUnstakeStartOffset)] =
+			common.BigToHash(big.NewInt(1))
+	}
+	reads := 0
+	read := func(slots []common.Hash) (map[common.Hash]common.Hash, error) {
+		reads++
+		values := map[common.Hash]common.Hash{}
+		for _, slot := range slots {
+			values[slot] = storage[slot]
+		}
+		return values, nil
+	}
+	indexes := func(indexes ...int64) []*big.Int {
+		retval := []*big.Int{}
+		for _, index := range indexes {
+			retval = append(retval, big.NewInt(index))
+		}
+		return retval
+	}
+	for _, c := range []struct {
+		previous int64
+		limit    int
+		indexes  []*big.Int
+		valid    bool
+	}{
+		{0, 10, indexes(1, 4, 6), true},
+		{0, 2, indexes(1, 4), true},
+		{0, 1, indexes(1), true},
+		{1, 1, indexes(4), true},
+		{4, 10, indexes(6), true},
+		{6, 10, indexes(), true},
+		// the list does not end
+		{0, 10, indexes(1, 4), false},
+		{0, 10, indexes(), false},
+		// an active bucket is skipped
+		{0, 2, indexes(1, 6), false},
+		{1, 1, indexes(6), false},
+		// unstaked or unknown buckets
+		{0, 2, indexes(1, 3), false},
+		{0, 10, indexes(1, 4, 6, 7), false},
+		{0, 2, indexes(4, 1), false},
+	} {
+		values, err := v.walkBuckets(read, big.NewInt(c.previous), c.limit, c.indexes, bucketCanNameOffset)
+		if !c.valid {
+			require.Equal(ErrUnverifiable, errors.Cause(err), "%d %v", c.previous, c.indexes)
+			continue
+		}
+		require.NoError(err, "%d %v", c.previous, c.indexes)
+		for _, index := range c.indexes {
+			slot := offsetSlot(mappingSlot(index, v.bucketsSlot), bucketCanNameOffset)
+			require.Equal(int64(100)+index.Int64(), values[slot].Big().Int64())
+		}
+	}
+
+	// the skipped buckets of both gaps are read in one batch
+	reads = 0
+	_, err := v.walkBuckets(read, big.NewInt(0), 10, indexes(1, 4, 6))
+	require.NoError(err)
+	require.Equal(2, reads)
+}
+
+func TestNumOfCandidates(t *testing.T) {
+	require := require.New(t)
+	require.Equal(0, numOfCandidates(big.NewInt(5), big.NewInt(5), 10))
+	require.Equal(0, numOfCandidates(big.NewInt(5), big.NewInt(7), 10))
+	require.Equal(4, numOfCandidates(big.NewInt(5), big.NewInt(1), 10))
+	require.Equal(2, numOfCandidates(big.NewInt(5), big.NewInt(1), 2))
+}
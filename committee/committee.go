@@ -14,7 +14,6 @@ import (
 	"context"
 	"math"
 	"math/big"
-	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -33,6 +32,24 @@ import (
 // Namespace to store the result in db
 const Namespace = "electionNS"
 
+// commitmentKeyPrefix is the prefix of the key to store the commitment of a result
+const commitmentKeyPrefix = "commitment-"
+
+// auditKeyPrefix is the prefix of the key to store the exclusion audit of a result
+const auditKeyPrefix = "audit-"
+
+// statisticsKeyPrefix is the prefix of the key to store the statistics of a result
+const statisticsKeyPrefix = "statistics-"
+
+// bucketIndexPageSize is the number of bucket indexes to list per call. The indexes are listed to
+// split the buckets into pages fetched in parallel, which costs an extra call per 1000 buckets on
+// top of the calls of fetching the pages.
+const bucketIndexPageSize = 1000
+
+// defaultPaginationSize is the number of buckets or candidates to fetch per call if none is
+// configured
+const defaultPaginationSize = 100
+
 // CalcGravityChainHeight calculates the corresponding gravity chain height for an epoch
 type CalcGravityChainHeight func(uint64) (uint64, error)
 
@@ -49,14 +66,26 @@ type Config struct {
 	ScoreThreshold             string   `yaml:"scoreThreshold"`
 	SelfStakingThreshold       string   `yaml:"selfStakingThreshold"`
 	CacheSize                  uint32   `yaml:"cacheSize"`
+	CacheMemoryLimit           uint64   `yaml:"cacheMemoryLimit"`
 	NumOfFetchInParallel       uint8    `yaml:"numOfFetchInParallel"`
+	NumOfPageFetchInParallel   uint8    `yaml:"numOfPageFetchInParallel"`
 	SkipManifiedCandidate      bool     `yaml:"skipManifiedCandidate"`
 	GravityChainBatchSize           uint64   `yaml:"gravityChainBatchSize"`
+	KeyframeInterval           uint64   `yaml:"keyframeInterval"`
+
+	Retention               RetentionConfig        `yaml:"retention"`
+	Verifier                carrier.VerifierConfig `yaml:"verifier"`
+	QuarantineRetryInterval time.Duration          `yaml:"quarantineRetryInterval"`
+	Replica                 ReplicaConfig          `yaml:"replica"`
+	Probation               ProbationConfig        `yaml:"probation"`
 }
 
 // STATUS represents the status of committee
 type STATUS uint8
 
+// activeWindow is the duration since the last update within which the committee is active
+const activeWindow = 60 * time.Second
+
 const (
 	// STARTING stands for a starting status
 	STARTING STATUS = iota
@@ -73,16 +102,47 @@ type Committee interface {
 	Start(context.Context) error
 	// Stop stops the committee service
 	Stop(context.Context) error
-	// ResultByHeight returns the result on a specific ethereum height
+	// ResultByHeight returns the result with votes on a specific ethereum height, or ErrPruned if the
+	// votes of the result have been pruned
 	ResultByHeight(height uint64) (*types.ElectionResult, error)
+	// SummaryByHeight returns the delegates and the totals of the result on a specific ethereum height
+	// without votes, which are kept after pruning
+	SummaryByHeight(height uint64) (*types.ElectionResult, error)
 	// FetchResultByHeight returns the votes
 	FetchResultByHeight(height uint64) (*types.ElectionResult, error)
 	// HeightByTime returns the nearest result before time
 	HeightByTime(timestamp time.Time) (uint64, error)
 	// LatestHeight returns the height with latest result
 	LatestHeight() uint64
+	// HeightAtOrBefore returns the nearest height with result at or before a height within the synced
+	// range, such that heights need not align with the interval
+	HeightAtOrBefore(height uint64) (uint64, error)
+	// HeightsSince returns at most limit heights with results from a height, all if limit is 0
+	HeightsSince(height uint64, limit int) []uint64
+	// Subscribe returns a channel signaled when new heights are stored, and a function to unsubscribe
+	Subscribe() (<-chan struct{}, func())
 	// Status returns the committee status
 	Status() STATUS
+	// CacheStats returns the statistics of the result cache
+	CacheStats() CacheStats
+	// CommitmentByHeight returns the merkle roots of the result on a specific ethereum height
+	CommitmentByHeight(height uint64) (*types.ResultCommitment, error)
+	// Failures returns the quarantined heights which failed to be synced, with which the committee is
+	// degraded while its Status is not affected
+	Failures() []ConsistencyFailure
+	// SyncStatus returns the detailed status of syncing
+	SyncStatus() SyncStatus
+	// AuditByHeight returns the candidates and votes excluded from the result on a specific ethereum height
+	AuditByHeight(height uint64) (*types.ExclusionAudit, error)
+	// StatisticsByHeight returns the decentralization and concentration metrics of the result on a
+	// specific ethereum height
+	StatisticsByHeight(height uint64) (*types.Statistics, error)
+	// Simulate recalculates the result on a specific ethereum height with hypothetical changes
+	Simulate(height uint64, scenario *Scenario) (*Simulation, error)
+	// PutProbationList stores the probation list of an epoch
+	PutProbationList(list *types.ProbationList) error
+	// ProbationListByEpoch returns the probation list of an epoch, which is empty if none is stored
+	ProbationListByEpoch(epoch uint64) (*types.ProbationList, error)
 }
 
 type committee struct {
@@ -91,18 +151,32 @@ type committee struct {
 	retryLimit            uint8
 	paginationSize        uint8
 	fetchInParallel       uint8
+	pageFetchInParallel   uint8
 	skipManifiedCandidate bool
 	voteThreshold         *big.Int
 	scoreThreshold        *big.Int
 	selfStakingThreshold  *big.Int
 	interval              uint64
+	keyframeInterval      uint64
+	legacyFormat          bool
+	migratedHeight        uint64
 
 	cache         *resultCache
 	heightManager *heightManager
+	feed          *heightFeed
+	retention     RetentionConfig
+	replica       ReplicaConfig
+	probation     ProbationConfig
+	prunedHeight  uint64
+	quarantine    *quarantine
+	stop          chan struct{}
 
 	startHeight         uint64
 	nextHeight          uint64
 	currentHeight       uint64
+	tipTime             time.Time
+	catchUpStartHeight  uint64
+	catchUpTargetHeight uint64
 	lastUpdateTimestamp int64
 	terminate           chan bool
 	mutex               sync.RWMutex
@@ -116,22 +190,30 @@ func NewCommitteeWithKVStoreWithNamespace(kvstore db.KVStoreWithNamespace, cfg C
 
 // NewCommittee creates a committee
 func NewCommittee(kvstore db.KVStore, cfg Config) (Committee, error) {
-	if !common.IsHexAddress(cfg.StakingContractAddress) {
-		return nil, errors.New("Invalid staking contract address")
-	}
-	carrier, err := carrier.NewEthereumVoteCarrier(
-		cfg.GravityChainAPIs,
-		common.HexToAddress(cfg.RegisterContractAddress),
-		common.HexToAddress(cfg.StakingContractAddress),
-	)
-	zap.L().Info(
-		"Carrier created",
-		zap.String("registerContractAddress", cfg.RegisterContractAddress),
-		zap.String("stakingContractAddress", cfg.StakingContractAddress),
-	)
-	if err != nil {
+	if err := cfg.Replica.validate(kvstore); err != nil {
 		return nil, err
 	}
+	var c carrier.Carrier
+	if !cfg.Replica.ReadOnly {
+		if !common.IsHexAddress(cfg.StakingContractAddress) {
+			return nil, errors.New("Invalid staking contract address")
+		}
+		var err error
+		c, err = carrier.NewVerifiableEthereumVoteCarrier(
+			cfg.GravityChainAPIs,
+			common.HexToAddress(cfg.RegisterContractAddress),
+			common.HexToAddress(cfg.StakingContractAddress),
+			cfg.Verifier,
+		)
+		zap.L().Info(
+			"Carrier created",
+			zap.String("registerContractAddress", cfg.RegisterContractAddress),
+			zap.String("stakingContractAddress", cfg.StakingContractAddress),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
 	voteThreshold, ok := new(big.Int).SetString(cfg.VoteThreshold, 10)
 	if !ok {
 		return nil, errors.New("Invalid vote threshold")
@@ -144,22 +226,41 @@ func NewCommittee(kvstore db.KVStore, cfg Config) (Committee, error) {
 	if !ok {
 		return nil, errors.New("Invalid self staking threshold")
 	}
+	paginationSize := uint8(defaultPaginationSize)
+	if cfg.PaginationSize > 0 {
+		paginationSize = cfg.PaginationSize
+	}
 	fetchInParallel := uint8(10)
 	if cfg.NumOfFetchInParallel > 0 {
 		fetchInParallel = cfg.NumOfFetchInParallel
 	}
+	pageFetchInParallel := uint8(4)
+	if cfg.NumOfPageFetchInParallel > 0 {
+		pageFetchInParallel = cfg.NumOfPageFetchInParallel
+	}
+	keyframeInterval := uint64(defaultKeyframeInterval)
+	if cfg.KeyframeInterval > 0 {
+		keyframeInterval = cfg.KeyframeInterval
+	}
 	gravityChainBatchSize := uint64(10)
 	if cfg.GravityChainBatchSize > 0 {
 		gravityChainBatchSize = cfg.GravityChainBatchSize
 	}
 	return &committee{
-		db:                    kvstore,
-		cache:                 newResultCache(cfg.CacheSize),
+		db:                    newEnvelopeStore(kvstore),
+		cache:                 newResultCache(cfg.CacheSize, cfg.CacheMemoryLimit),
 		heightManager:         newHeightManager(),
-		carrier:               carrier,
+		feed:                  newHeightFeed(),
+		retention:             cfg.Retention,
+		replica:               cfg.Replica,
+		probation:             cfg.Probation,
+		quarantine:            newQuarantine(cfg.QuarantineRetryInterval),
+		stop:                  make(chan struct{}),
+		carrier:               c,
 		retryLimit:            cfg.NumOfRetries,
-		paginationSize:        cfg.PaginationSize,
+		paginationSize:        paginationSize,
 		fetchInParallel:       fetchInParallel,
+		pageFetchInParallel:   pageFetchInParallel,
 		skipManifiedCandidate: cfg.SkipManifiedCandidate,
 		voteThreshold:         voteThreshold,
 		scoreThreshold:        scoreThreshold,
@@ -167,9 +268,10 @@ func NewCommittee(kvstore db.KVStore, cfg Config) (Committee, error) {
 		terminate:             make(chan bool),
 		startHeight:           cfg.GravityChainStartHeight,
 		interval:              cfg.GravityChainHeightInterval,
+		keyframeInterval:      keyframeInterval,
 		currentHeight:         0,
 		nextHeight:            cfg.GravityChainStartHeight,
-		gravityChainBatchSize:      gravityChainBatchSize,
+		gravityChainBatchSize: gravityChainBatchSize,
 	}, nil
 }
 
@@ -179,25 +281,25 @@ func (ec *committee) Start(ctx context.Context) (err error) {
 	if err := ec.db.Start(ctx); err != nil {
 		return errors.Wrap(err, "error when starting db")
 	}
-	if startHeight, err := ec.db.Get(db.NextHeightKey); err == nil {
-		zap.L().Info("restoring from db")
-		ec.nextHeight = util.BytesToUint64(startHeight)
-		for height := ec.startHeight; height < ec.nextHeight; height += ec.interval {
-			zap.L().Info("loading", zap.Uint64("height", height))
-			data, err := ec.db.Get(ec.dbKey(height))
-			if err != nil {
-				return err
-			}
-			r := &types.ElectionResult{}
-			if err := r.Deserialize(data); err != nil {
-				return err
-			}
-			ec.cache.insert(height, r)
-			if err := ec.heightManager.add(height, r.MintTime()); err != nil {
-				return err
-			}
+	if !ec.replica.ReadOnly {
+		if err := ec.migrate(); err != nil {
+			return errors.Wrap(err, "failed to migrate db")
+		}
+		if err := ec.loadProbationLists(); err != nil {
+			return err
 		}
 	}
+	zap.L().Info("restoring from db")
+	if err := ec.load(); err != nil {
+		return err
+	}
+	if ec.replica.ReadOnly {
+		ec.startWatching()
+		return nil
+	}
+	ec.startPruning()
+	ec.startSnapshotting()
+	ec.startRetryingQuarantined()
 
 	tip, err := ec.carrier.Tip()
 	if err != nil {
@@ -206,21 +308,8 @@ func (ec *committee) Start(ctx context.Context) (err error) {
 	tipChan := make(chan *carrier.TipInfo)
 	reportChan := make(chan error)
 	go func() {
-		zap.L().Info("catching up via network")
-		gap := ec.interval * ec.gravityChainBatchSize
-		for h := ec.nextHeight + gap; h < tip.Height; h += gap {
-			zap.L().Info("catching up to", zap.Uint64("height", h))
-			results, errs := ec.fetchInBatch(h)
-			t, err := ec.carrier.BlockTimestamp(h)
-			if err != nil {
-				zap.L().Error("failed to get block timestamp", zap.Uint64("height", h), zap.Error(err))
-			}
-			if err := ec.storeInBatch(results, errs, t); err != nil {
-				zap.L().Error("failed to catch up via network", zap.Uint64("height", h), zap.Error(err))
-			}
-		}
-		results, errs := ec.fetchInBatch(tip.Height)
-		if err := ec.storeInBatch(results, errs, tip.BlockTime); err != nil {
+		zap.L().Info("catching up via network", zap.Uint64("tip", tip.Height))
+		if err := ec.Sync(tip.Height, tip.BlockTime); err != nil {
 			zap.L().Error("failed to catch up via network", zap.Error(err))
 		}
 		zap.L().Info("subscribing to new block")
@@ -246,8 +335,11 @@ func (ec *committee) Start(ctx context.Context) (err error) {
 func (ec *committee) Stop(ctx context.Context) error {
 	ec.mutex.Lock()
 	defer ec.mutex.Unlock()
-	ec.terminate <- true
-	ec.carrier.Close()
+	close(ec.stop)
+	if !ec.replica.ReadOnly {
+		ec.terminate <- true
+		ec.carrier.Close()
+	}
 
 	return ec.db.Stop(ctx)
 }
@@ -257,82 +349,156 @@ func (ec *committee) Status() STATUS {
 	switch {
 	case lastUpdateTimestamp == 0:
 		return STARTING
-	case lastUpdateTimestamp > time.Now().Add(-60*time.Second).Unix():
+	case lastUpdateTimestamp > time.Now().Add(-activeWindow).Unix():
 		return ACTIVE
 	default:
 		return INACTIVE
 	}
 }
 
+// Sync fetches the results up to the tip and stores them in height order. The results are
+// committed one by one as soon as they are contiguous, such that the progress is kept even if the
+// sync is interrupted.
 func (ec *committee) Sync(tipHeight uint64, tipTime time.Time) error {
-	results, errs := ec.fetchInBatch(tipHeight)
 	ec.mutex.Lock()
-	defer ec.mutex.Unlock()
+	if ec.currentHeight < tipHeight {
+		ec.currentHeight = tipHeight
+		ec.tipTime = tipTime
+	}
+	start := ec.nextHeight
+	currentHeight := ec.currentHeight
+	// only the results of heights with enough confirmations are fetched
+	if currentHeight < start+12 {
+		ec.mutex.Unlock()
+		ec.markSynced(tipTime)
+		return nil
+	}
+	ec.catchUpStartHeight = start
+	ec.catchUpTargetHeight = currentHeight - 12
+	end := ec.catchUpTargetHeight
+	// a quarantined height is left to the retries with backoff, so the sync stops before it
+	pending, blocked := ec.quarantine.pending(time.Now())
+	blocked = blocked && pending <= end
+	if blocked {
+		if pending <= start {
+			ec.mutex.Unlock()
+			return nil
+		}
+		end = pending - ec.interval
+	}
+	ec.mutex.Unlock()
+	pipeline := &fetchPipeline{
+		start:    start,
+		end:      end,
+		interval: ec.interval,
+		workers:  int(ec.fetchInParallel),
+		window:   int(ec.gravityChainBatchSize),
+		fetch:    ec.retryFetchResultByHeight,
+	}
+	if err := pipeline.run(ec.commit); err != nil {
+		return err
+	}
+	if !blocked {
+		ec.markSynced(tipTime)
+	}
 
-	return ec.storeInBatch(results, errs, tipTime)
+	return nil
 }
 
-func (ec *committee) fetchInBatch(tipHeight uint64) (
-	map[uint64]*types.ElectionResult,
-	map[uint64]error,
-) {
-	if ec.currentHeight < tipHeight {
-		ec.currentHeight = tipHeight
+// markSynced updates the last update timestamp with the tip time, and persists it for replicas
+func (ec *committee) markSynced(tipTime time.Time) {
+	zap.L().Info("synced to", zap.Time("block time", tipTime))
+	ec.updateTimestamp(tipTime)
+	if err := ec.db.Put(db.LastUpdateTimeKey, util.Uint64ToBytes(uint64(tipTime.Unix()))); err != nil {
+		zap.L().Error("failed to persist last update time", zap.Error(err))
 	}
-	var wg sync.WaitGroup
-	limiter := make(chan bool, ec.fetchInParallel)
-	results := map[uint64]*types.ElectionResult{}
-	errs := map[uint64]error{}
-	for nextHeight := ec.nextHeight; nextHeight <= ec.currentHeight-12; nextHeight += ec.interval {
-		wg.Add(1)
-		go func(height uint64) {
-			defer func() {
-				<-limiter
-				wg.Done()
-			}()
-			limiter <- true
-			results[height], errs[height] = ec.retryFetchResultByHeight(height)
-		}(nextHeight)
+}
+
+// updateTimestamp moves the last update timestamp forward
+func (ec *committee) updateTimestamp(ts time.Time) {
+	for {
+		lastUpdateTimestamp := atomic.LoadInt64(&ec.lastUpdateTimestamp)
+		if ts.Unix() <= lastUpdateTimestamp ||
+			atomic.CompareAndSwapInt64(&ec.lastUpdateTimestamp, lastUpdateTimestamp, ts.Unix()) {
+			return
+		}
 	}
-	wg.Wait()
+}
+
+// commit stores a fetched result if it is the next height to store
+func (ec *committee) commit(
+	height uint64,
+	result *types.ElectionResult,
+	audit *types.ExclusionAudit,
+	err error,
+) error {
+	ec.mutex.Lock()
+	defer ec.mutex.Unlock()
+	switch {
+	case height < ec.nextHeight:
+		// the height has been stored by a retry of quarantined height
+		return nil
+	case height > ec.nextHeight:
+		return errors.Errorf("height %d is not the next height %d to store", height, ec.nextHeight)
+	}
+	if err != nil {
+		ec.quarantine.record(height, err, time.Now())
+		return errors.Wrapf(err, "failed to fetch result of height %d", height)
+	}
+	if err := ec.storeValidResult(height, result, audit); err != nil {
+		return err
+	}
+	ec.updateTimestamp(result.MintTime())
 
-	return results, errs
+	return nil
 }
 
-func (ec *committee) storeInBatch(
-	results map[uint64]*types.ElectionResult,
-	errs map[uint64]error,
-	tipTime time.Time,
+// storeValidResult stores the result of the next height if it is consistent with the stored ones,
+// otherwise the height will be quarantined
+func (ec *committee) storeValidResult(
+	height uint64,
+	result *types.ElectionResult,
+	audit *types.ExclusionAudit,
 ) error {
-	var heights []uint64
-	for height := range results {
-		heights = append(heights, height)
-	}
-	sort.Slice(heights, func(i, j int) bool {
-		return heights[i] < heights[j]
-	})
-	for _, height := range heights {
-		result := results[height]
-		if err := errs[height]; err != nil {
-			return err
-		}
-		if err := ec.heightManager.validate(height, result.MintTime()); err != nil {
-			zap.L().Fatal(
-				"Unexpected status that the upcoming block height or time is invalid",
-				zap.Error(err),
-			)
-		}
-		if err := ec.storeResult(height, result); err != nil {
-			return errors.Wrapf(err, "failed to store result of height %d", height)
-		}
-		ec.nextHeight = height + ec.interval
+	if err := ec.heightManager.validate(height, result.MintTime()); err != nil {
+		zap.L().Error(
+			"Unexpected status that the upcoming block height or time is invalid",
+			zap.Uint64("height", height),
+			zap.Error(err),
+		)
+		ec.quarantine.record(height, err, time.Now())
+		return errors.Wrapf(err, "inconsistent result of height %d", height)
 	}
-	zap.L().Info("synced to", zap.Time("block time", tipTime))
-	atomic.StoreInt64(&ec.lastUpdateTimestamp, tipTime.Unix())
+	if err := ec.storeResult(height, result, audit); err != nil {
+		ec.quarantine.record(height, err, time.Now())
+		return errors.Wrapf(err, "failed to store result of height %d", height)
+	}
+	ec.nextHeight = height + ec.interval
+	ec.quarantine.resolve(height)
 
 	return nil
 }
 
+func (ec *committee) HeightAtOrBefore(height uint64) (uint64, error) {
+	ec.mutex.RLock()
+	defer ec.mutex.RUnlock()
+	h, ok := ec.heightManager.heightAtOrBefore(height)
+	if !ok {
+		return 0, db.ErrNotExist
+	}
+	return h, nil
+}
+
+func (ec *committee) HeightsSince(height uint64, limit int) []uint64 {
+	ec.mutex.RLock()
+	defer ec.mutex.RUnlock()
+	return ec.heightManager.heightsSince(height, limit)
+}
+
+func (ec *committee) Subscribe() (<-chan struct{}, func()) {
+	return ec.feed.subscribe()
+}
+
 func (ec *committee) LatestHeight() uint64 {
 	ec.mutex.RLock()
 	defer ec.mutex.RUnlock()
@@ -363,7 +529,27 @@ func (ec *committee) HeightByTime(ts time.Time) (uint64, error) {
 func (ec *committee) ResultByHeight(height uint64) (*types.ElectionResult, error) {
 	ec.mutex.RLock()
 	defer ec.mutex.RUnlock()
-	return ec.resultByHeight(height)
+	result, err := ec.resultByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	if result.Pruned() {
+		return nil, ErrPruned
+	}
+	return result, nil
+}
+
+func (ec *committee) SummaryByHeight(height uint64) (*types.ElectionResult, error) {
+	ec.mutex.RLock()
+	defer ec.mutex.RUnlock()
+	result, err := ec.resultByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	if result.Pruned() {
+		return result, nil
+	}
+	return result.Summary(), nil
 }
 
 func (ec *committee) resultByHeight(height uint64) (*types.ElectionResult, error) {
@@ -384,16 +570,84 @@ func (ec *committee) resultByHeight(height uint64) (*types.ElectionResult, error
 	if result != nil {
 		return result, nil
 	}
-	data, err := ec.db.Get(ec.dbKey(height))
+	result, err := ec.readResult(height)
+	if err != nil {
+		return nil, err
+	}
+	ec.cache.admit(height, result)
+
+	return result, nil
+}
+
+func (ec *committee) CommitmentByHeight(height uint64) (*types.ResultCommitment, error) {
+	ec.mutex.RLock()
+	defer ec.mutex.RUnlock()
+	c := &types.ResultCommitment{}
+	data, err := ec.db.Get(ec.commitmentKey(height))
+	if err == nil && data != nil {
+		return c, c.Deserialize(data)
+	}
+	// the commitment is missing for results stored by an older version
+	result, err := ec.resultByHeight(height)
 	if err != nil {
 		return nil, err
 	}
-	if data == nil {
+	if result.Pruned() {
+		return nil, ErrPruned
+	}
+
+	return result.Commitment()
+}
+
+func (ec *committee) AuditByHeight(height uint64) (*types.ExclusionAudit, error) {
+	ec.mutex.RLock()
+	defer ec.mutex.RUnlock()
+	if height >= ec.nextHeight {
 		return nil, db.ErrNotExist
 	}
-	result = &types.ElectionResult{}
+	data, err := ec.db.Get(ec.auditKey(height))
+	if err != nil {
+		return nil, err
+	}
+	audit := &types.ExclusionAudit{}
+	if err := audit.Deserialize(data); err != nil {
+		return nil, err
+	}
 
-	return result, result.Deserialize(data)
+	return audit, nil
+}
+
+func (ec *committee) StatisticsByHeight(height uint64) (*types.Statistics, error) {
+	ec.mutex.RLock()
+	defer ec.mutex.RUnlock()
+	if height >= ec.nextHeight {
+		return nil, db.ErrNotExist
+	}
+	data, err := ec.db.Get(ec.statisticsKey(height))
+	switch errors.Cause(err) {
+	case nil:
+		stats := &types.Statistics{}
+		if err := stats.Deserialize(data); err != nil {
+			return nil, err
+		}
+		return stats, nil
+	case db.ErrNotExist:
+		// results stored without statistics
+		result, err := ec.resultByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		if result.Pruned() {
+			return nil, ErrPruned
+		}
+		return types.NewStatistics(result)
+	default:
+		return nil, err
+	}
+}
+
+func (ec *committee) CacheStats() CacheStats {
+	return ec.cache.stats()
 }
 
 func (ec *committee) calcWeightedVotes(v *types.Vote, now time.Time) *big.Int {
@@ -411,33 +665,86 @@ func (ec *committee) calcWeightedVotes(v *types.Vote, now time.Time) *big.Int {
 	return weightedAmount
 }
 
-func (ec *committee) fetchVotesByHeight(height uint64) ([]*types.Vote, error) {
-	var allVotes []*types.Vote
+// fetchPageStarts returns the previous index of each page of active buckets on height
+func (ec *committee) fetchPageStarts(height uint64) ([]*big.Int, error) {
+	starts := []*big.Int{big.NewInt(0)}
 	previousIndex := big.NewInt(0)
+	count := 0
 	for {
-		var votes []*types.Vote
+		var indexes []*big.Int
 		var err error
-		if previousIndex, votes, err = ec.carrier.Votes(
+		if previousIndex, indexes, err = ec.carrier.BucketIndexes(
 			height,
 			previousIndex,
-			ec.paginationSize,
+			bucketIndexPageSize,
 		); err != nil {
 			return nil, err
 		}
-		allVotes = append(allVotes, votes...)
-		if len(votes) < int(ec.paginationSize) {
+		for _, index := range indexes {
+			count++
+			if count%int(ec.paginationSize) == 0 {
+				starts = append(starts, index)
+			}
+		}
+		if len(indexes) < bucketIndexPageSize {
 			break
 		}
 	}
+	if count%int(ec.paginationSize) == 0 {
+		// the last page is empty
+		starts = starts[:len(starts)-1]
+	}
 
-	return allVotes, nil
+	return starts, nil
 }
+
+// addVotesByHeight fetches the pages of votes in parallel, each of which is added to a shard of the
+// calculator, and merges the shards back in page order
+func (ec *committee) addVotesByHeight(height uint64, calculator *types.ResultCalculator) error {
+	starts, err := ec.fetchPageStarts(height)
+	if err != nil {
+		return err
+	}
+	shards := make([]*types.ResultCalculator, len(starts))
+	for i := range shards {
+		if shards[i], err = calculator.Shard(); err != nil {
+			return err
+		}
+	}
+	errs := make([]error, len(starts))
+	limiter := make(chan struct{}, ec.pageFetchInParallel)
+	var wg sync.WaitGroup
+	for i, start := range starts {
+		wg.Add(1)
+		limiter <- struct{}{}
+		go func(i int, start *big.Int) {
+			defer func() {
+				<-limiter
+				wg.Done()
+			}()
+			_, votes, err := ec.carrier.Votes(height, start, ec.paginationSize)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			errs[i] = shards[i].AddVotes(votes)
+		}(i, start)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			return errors.Wrapf(err, "failed to add page %d of votes", i)
+		}
+	}
+
+	return calculator.Merge(shards...)
+}
+
 func (ec *committee) voteFilter(v *types.Vote) bool {
 	return ec.voteThreshold.Cmp(v.Amount()) > 0
 }
-func (ec *committee) candidateFilter(c *types.Candidate) bool {
-	return ec.selfStakingThreshold.Cmp(c.SelfStakingTokens()) > 0 ||
-		ec.scoreThreshold.Cmp(c.Score()) > 0
+func (ec *committee) candidateFilter(c *types.Candidate) (types.ExclusionReason, bool) {
+	return types.ThresholdFilter(ec.scoreThreshold, ec.selfStakingThreshold)(c)
 }
 func (ec *committee) calculator(height uint64) (*types.ResultCalculator, error) {
 	mintTime, err := ec.carrier.BlockTimestamp(height)
@@ -449,7 +756,7 @@ func (ec *committee) calculator(height uint64) (*types.ResultCalculator, error)
 	default:
 		return nil, err
 	}
-	return types.NewResultCalculator(
+	return types.NewResultCalculatorWithExclusion(
 		mintTime,
 		ec.skipManifiedCandidate,
 		ec.voteFilter,
@@ -480,6 +787,9 @@ func (ec *committee) fetchCandidatesByHeight(height uint64) ([]*types.Candidate,
 }
 
 func (ec *committee) FetchResultByHeight(height uint64) (*types.ElectionResult, error) {
+	if ec.replica.ReadOnly {
+		return nil, ErrReadOnly
+	}
 	if height == 0 {
 		tip, err := ec.carrier.Tip()
 		if err != nil {
@@ -487,59 +797,120 @@ func (ec *committee) FetchResultByHeight(height uint64) (*types.ElectionResult,
 		}
 		height = tip.Height
 	}
-	return ec.fetchResultByHeight(height)
+	result, _, err := ec.fetchResultByHeight(height)
+
+	return result, err
 }
 
-func (ec *committee) fetchResultByHeight(height uint64) (*types.ElectionResult, error) {
+func (ec *committee) fetchResultByHeight(height uint64) (*types.ElectionResult, *types.ExclusionAudit, error) {
 	zap.L().Info("fetch result from ethereum", zap.Uint64("height", height))
 	calculator, err := ec.calculator(height)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	candidates, err := ec.fetchCandidatesByHeight(height)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if err := calculator.AddCandidates(candidates); err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if err := ec.addVotesByHeight(height, calculator); err != nil {
+		return nil, nil, err
 	}
-	votes, err := ec.fetchVotesByHeight(height)
+	result, err := calculator.Calculate()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	if err := calculator.AddVotes(votes); err != nil {
-		return nil, err
+	audit, err := calculator.Audit()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return calculator.Calculate()
+	return result, audit, nil
 }
 
 func (ec *committee) dbKey(height uint64) []byte {
 	return util.Uint64ToBytes(height)
 }
 
-func (ec *committee) storeResult(height uint64, result *types.ElectionResult) error {
-	data, err := result.Serialize()
+func (ec *committee) commitmentKey(height uint64) []byte {
+	return append([]byte(commitmentKeyPrefix), ec.dbKey(height)...)
+}
+
+func (ec *committee) auditKey(height uint64) []byte {
+	return append([]byte(auditKeyPrefix), ec.dbKey(height)...)
+}
+
+func (ec *committee) statisticsKey(height uint64) []byte {
+	return append([]byte(statisticsKeyPrefix), ec.dbKey(height)...)
+}
+
+func (ec *committee) storeResult(
+	height uint64,
+	result *types.ElectionResult,
+	audit *types.ExclusionAudit,
+) error {
+	if err := ec.putResult(height, result, ec.deltaBase(height, result)); err != nil {
+		return err
+	}
+	var data []byte
+	var err error
+	if audit != nil {
+		if data, err = audit.Serialize(); err != nil {
+			return err
+		}
+		if err := ec.db.Put(ec.auditKey(height), data); err != nil {
+			return errors.Wrapf(err, "failed to put exclusion audit into db")
+		}
+	}
+	if !result.Pruned() {
+		stats, err := types.NewStatistics(result)
+		if err != nil {
+			return err
+		}
+		if data, err = stats.Serialize(); err != nil {
+			return err
+		}
+		if err := ec.db.Put(ec.statisticsKey(height), data); err != nil {
+			return errors.Wrapf(err, "failed to put statistics into db")
+		}
+	}
+	commitment, err := result.Commitment()
 	if err != nil {
 		return err
 	}
-	if err := ec.db.Put(ec.dbKey(height), data); err != nil {
-		return errors.Wrapf(err, "failed to put election result into db")
+	if data, err = commitment.Serialize(); err != nil {
+		return err
+	}
+	if err := ec.db.Put(ec.commitmentKey(height), data); err != nil {
+		return errors.Wrapf(err, "failed to put commitment into db")
 	}
 	if err := ec.db.Put(db.NextHeightKey, ec.dbKey(height+ec.interval)); err != nil {
 		return err
 	}
 	ec.cache.insert(height, result)
 
-	return ec.heightManager.add(height, result.MintTime())
+	return ec.addHeight(height, result.MintTime())
 }
 
-func (ec *committee) retryFetchResultByHeight(height uint64) (*types.ElectionResult, error) {
+// addHeight adds a stored height and signals the subscribers
+func (ec *committee) addHeight(height uint64, mintTime time.Time) error {
+	if err := ec.heightManager.add(height, mintTime); err != nil {
+		return err
+	}
+	ec.feed.publish()
+
+	return nil
+}
+
+func (ec *committee) retryFetchResultByHeight(height uint64) (*types.ElectionResult, *types.ExclusionAudit, error) {
 	var result *types.ElectionResult
+	var audit *types.ExclusionAudit
 	var err error
 	for i := uint8(0); i < ec.retryLimit; i++ {
-		if result, err = ec.fetchResultByHeight(height); err == nil {
-			return result, nil
+		if result, audit, err = ec.fetchResultByHeight(height); err == nil {
+			return result, audit, nil
 		}
 		zap.L().Error(
 			"failed to fetch result by height",
@@ -548,5 +919,5 @@ func (ec *committee) retryFetchResultByHeight(height uint64) (*types.ElectionRes
 			zap.Uint8("tried", i+1),
 		)
 	}
-	return result, err
+	return result, audit, err
 }
@@ -17,9 +17,47 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotexproject/iotex-election/carrier"
+	"github.com/iotexproject/iotex-election/db"
 	"github.com/iotexproject/iotex-election/types"
 )
 
+// pagedCarrier serves the votes as buckets indexed from 1
+type pagedCarrier struct {
+	carrier.Carrier
+	votes []*types.Vote
+}
+
+func (c *pagedCarrier) page(previousIndex *big.Int, count int) []int {
+	indexes := []int{}
+	for i := int(previousIndex.Int64()) + 1; i <= len(c.votes) && len(indexes) < count; i++ {
+		indexes = append(indexes, i)
+	}
+	return indexes
+}
+
+func (c *pagedCarrier) BucketIndexes(
+	_ uint64,
+	previousIndex *big.Int,
+	count uint32,
+) (*big.Int, []*big.Int, error) {
+	indexes := []*big.Int{}
+	for _, i := range c.page(previousIndex, int(count)) {
+		indexes = append(indexes, big.NewInt(int64(i)))
+		previousIndex = big.NewInt(int64(i))
+	}
+	return previousIndex, indexes, nil
+}
+
+func (c *pagedCarrier) Votes(_ uint64, previousIndex *big.Int, count uint8) (*big.Int, []*types.Vote, error) {
+	votes := []*types.Vote{}
+	for _, i := range c.page(previousIndex, int(count)) {
+		votes = append(votes, c.votes[i-1])
+		previousIndex = big.NewInt(int64(i))
+	}
+	return previousIndex, votes, nil
+}
+
 func TestCalcWeightedVotes(t *testing.T) {
 	require := require.New(t)
 	committee := &committee{}
@@ -103,7 +141,9 @@ func TestCandidateFilter(t *testing.T) {
 	)
 	candidate1.SetScore(big.NewInt(9))
 	candidate1.SetSelfStakingTokens(big.NewInt(9))
-	require.True(committee.candidateFilter(candidate1))
+	reason, excluded := committee.candidateFilter(candidate1)
+	require.True(excluded)
+	require.Equal(types.LowSelfStakingCandidate, reason)
 	// candidate2 selfStaking is below committee's threshold,score is bigger than committee's threshold
 	candidate2 := types.NewCandidate(
 		[]byte("candidate2"),
@@ -114,7 +154,9 @@ func TestCandidateFilter(t *testing.T) {
 	)
 	candidate2.SetScore(big.NewInt(11))
 	candidate2.SetSelfStakingTokens(big.NewInt(9))
-	require.True(committee.candidateFilter(candidate2))
+	reason, excluded = committee.candidateFilter(candidate2)
+	require.True(excluded)
+	require.Equal(types.LowSelfStakingCandidate, reason)
 	// candidate3 selfStaking is bigger than committee's threshold,score is smaller than committee's threshold
 	candidate3 := types.NewCandidate(
 		[]byte("candidate3"),
@@ -125,7 +167,9 @@ func TestCandidateFilter(t *testing.T) {
 	)
 	candidate3.SetScore(big.NewInt(9))
 	candidate3.SetSelfStakingTokens(big.NewInt(11))
-	require.True(committee.candidateFilter(candidate3))
+	reason, excluded = committee.candidateFilter(candidate3)
+	require.True(excluded)
+	require.Equal(types.LowScoreCandidate, reason)
 	// candidate3 selfStaking and score both bigger than committee's threshold
 	candidate4 := types.NewCandidate(
 		[]byte("candidate4"),
@@ -136,5 +180,83 @@ func TestCandidateFilter(t *testing.T) {
 	)
 	candidate4.SetScore(big.NewInt(11))
 	candidate4.SetSelfStakingTokens(big.NewInt(11))
-	require.False(committee.candidateFilter(candidate4))
+	_, excluded = committee.candidateFilter(candidate4)
+	require.False(excluded)
+}
+
+func TestAddVotesByHeight(t *testing.T) {
+	require := require.New(t)
+	mintTime := time.Now()
+	candidates := []*types.Candidate{
+		types.NewCandidate([]byte("candidate1"), []byte("addr1"), nil, nil, 1),
+		types.NewCandidate([]byte("candidate2"), []byte("addr2"), nil, nil, 1),
+	}
+	for _, numOfVotes := range []int{0, 7, 20, 25} {
+		votes := []*types.Vote{}
+		for i := 0; i < numOfVotes; i++ {
+			vote, err := types.NewVote(
+				mintTime.Add(-time.Duration(i)*time.Hour),
+				time.Duration(i%3)*24*time.Hour,
+				big.NewInt(int64(100+i)),
+				big.NewInt(0),
+				[]byte{byte(i)},
+				candidates[i%2].Name(),
+				i%4 == 0,
+			)
+			require.NoError(err)
+			votes = append(votes, vote)
+		}
+		ec := &committee{
+			carrier:              &pagedCarrier{votes: votes},
+			paginationSize:       10,
+			pageFetchInParallel:  2,
+			voteThreshold:        big.NewInt(105),
+			scoreThreshold:       big.NewInt(0),
+			selfStakingThreshold: big.NewInt(0),
+		}
+		starts, err := ec.fetchPageStarts(0)
+		require.NoError(err)
+		require.Equal((numOfVotes+9)/10, len(starts))
+		newCalculator := func() *types.ResultCalculator {
+			calculator := types.NewResultCalculatorWithExclusion(
+				mintTime,
+				false,
+				ec.voteFilter,
+				ec.calcWeightedVotes,
+				ec.candidateFilter,
+			)
+			require.NoError(calculator.AddCandidates(candidates))
+			return calculator
+		}
+		serial := newCalculator()
+		require.NoError(serial.AddVotes(votes))
+		expected, err := serial.Calculate()
+		require.NoError(err)
+		parallel := newCalculator()
+		require.NoError(ec.addVotesByHeight(0, parallel))
+		result, err := parallel.Calculate()
+		require.NoError(err)
+		expectedBytes, err := expected.Serialize()
+		require.NoError(err)
+		resultBytes, err := result.Serialize()
+		require.NoError(err)
+		require.Equal(expectedBytes, resultBytes)
+	}
+}
+
+func TestDefaultPaginationSize(t *testing.T) {
+	require := require.New(t)
+	cfg := Config{
+		VoteThreshold:        "0",
+		ScoreThreshold:       "0",
+		SelfStakingThreshold: "0",
+		Replica:              ReplicaConfig{ReadOnly: true},
+	}
+	c, err := NewCommittee(db.NewInMemKVStore(), cfg)
+	require.NoError(err)
+	require.Equal(uint8(defaultPaginationSize), c.(*committee).paginationSize)
+	cfg.PaginationSize = 10
+	c, err = NewCommittee(db.NewInMemKVStore(), cfg)
+	require.NoError(err)
+	require.Equal(uint8(10), c.(*committee).paginationSize)
 }
@@ -0,0 +1,210 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultQuarantineRetryInterval is the initial interval between two retries of a quarantined
+	// height if none is configured
+	defaultQuarantineRetryInterval = 30 * time.Second
+	// maxQuarantineRetryInterval is the max interval between two retries of a quarantined height
+	maxQuarantineRetryInterval = 30 * time.Minute
+)
+
+// ConsistencyFailure defines a failure of syncing a height, which has been quarantined and will be
+// retried in the background
+type ConsistencyFailure struct {
+	Height       uint64
+	Cause        string
+	Attempts     uint32
+	FirstFailure time.Time
+	LastFailure  time.Time
+	NextRetry    time.Time
+}
+
+type quarantineEntry struct {
+	failure ConsistencyFailure
+	backoff *backoff.ExponentialBackOff
+}
+
+// quarantine keeps the heights failed to be fetched or validated
+type quarantine struct {
+	retryInterval time.Duration
+	entries       map[uint64]*quarantineEntry
+	mutex         sync.RWMutex
+}
+
+func newQuarantine(retryInterval time.Duration) *quarantine {
+	if retryInterval <= 0 {
+		retryInterval = defaultQuarantineRetryInterval
+	}
+	return &quarantine{
+		retryInterval: retryInterval,
+		entries:       map[uint64]*quarantineEntry{},
+	}
+}
+
+// record puts a height into quarantine, or updates the failure if it has been quarantined
+func (q *quarantine) record(height uint64, cause error, now time.Time) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	entry, exists := q.entries[height]
+	if !exists {
+		b := backoff.NewExponentialBackOff()
+		b.InitialInterval = q.retryInterval
+		b.MaxInterval = maxQuarantineRetryInterval
+		b.MaxElapsedTime = 0
+		b.Reset()
+		entry = &quarantineEntry{
+			failure: ConsistencyFailure{
+				Height:       height,
+				FirstFailure: now,
+			},
+			backoff: b,
+		}
+		q.entries[height] = entry
+	}
+	entry.failure.Cause = cause.Error()
+	entry.failure.Attempts++
+	entry.failure.LastFailure = now
+	entry.failure.NextRetry = now.Add(entry.backoff.NextBackOff())
+}
+
+// resolve releases a height from quarantine
+func (q *quarantine) resolve(height uint64) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if _, exists := q.entries[height]; exists {
+		delete(q.entries, height)
+		zap.L().Info("quarantined height recovered", zap.Uint64("height", height))
+	}
+}
+
+// resolveBefore releases the heights lower than the given height from quarantine
+func (q *quarantine) resolveBefore(height uint64) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	for h := range q.entries {
+		if h < height {
+			delete(q.entries, h)
+		}
+	}
+}
+
+// due returns the lowest quarantined height if it is time to retry it
+func (q *quarantine) due(now time.Time) (uint64, bool) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+	var lowest *quarantineEntry
+	for _, entry := range q.entries {
+		if lowest == nil || entry.failure.Height < lowest.failure.Height {
+			lowest = entry
+		}
+	}
+	if lowest == nil || now.Before(lowest.failure.NextRetry) {
+		return 0, false
+	}
+	return lowest.failure.Height, true
+}
+
+// pending returns the lowest quarantined height whose retry is not due yet
+func (q *quarantine) pending(now time.Time) (uint64, bool) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+	var lowest *quarantineEntry
+	for _, entry := range q.entries {
+		if !now.Before(entry.failure.NextRetry) {
+			continue
+		}
+		if lowest == nil || entry.failure.Height < lowest.failure.Height {
+			lowest = entry
+		}
+	}
+	if lowest == nil {
+		return 0, false
+	}
+	return lowest.failure.Height, true
+}
+
+// failures returns the quarantined failures in height order
+func (q *quarantine) failures() []ConsistencyFailure {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+	retval := make([]ConsistencyFailure, 0, len(q.entries))
+	for _, entry := range q.entries {
+		retval = append(retval, entry.failure)
+	}
+	sort.Slice(retval, func(i, j int) bool {
+		return retval[i].Height < retval[j].Height
+	})
+	return retval
+}
+
+func (ec *committee) startRetryingQuarantined() {
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ec.stop:
+				return
+			case <-ticker.C:
+				ec.retryQuarantined()
+			}
+		}
+	}()
+}
+
+// retryQuarantined retries the lowest quarantined height if it is due, which blocks the sync
+func (ec *committee) retryQuarantined() {
+	height, ok := ec.quarantine.due(time.Now())
+	if !ok {
+		return
+	}
+	ec.mutex.RLock()
+	nextHeight := ec.nextHeight
+	ec.mutex.RUnlock()
+	if height < nextHeight {
+		// the height has been synced in another way
+		ec.quarantine.resolveBefore(nextHeight)
+		return
+	}
+	if height != nextHeight {
+		// wait for the lower heights to be synced first
+		return
+	}
+	zap.L().Info("retrying quarantined height", zap.Uint64("height", height))
+	result, audit, err := ec.fetchResultByHeight(height)
+	ec.mutex.Lock()
+	defer ec.mutex.Unlock()
+	if height != ec.nextHeight {
+		return
+	}
+	if err != nil {
+		ec.quarantine.record(height, err, time.Now())
+		return
+	}
+	if err := ec.storeValidResult(height, result, audit); err != nil {
+		zap.L().Error("failed to store quarantined height", zap.Uint64("height", height), zap.Error(err))
+	}
+}
+
+func (ec *committee) Failures() []ConsistencyFailure {
+	return ec.quarantine.failures()
+}
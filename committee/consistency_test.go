@@ -0,0 +1,133 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-election/carrier"
+	"github.com/iotexproject/iotex-election/db"
+	"github.com/iotexproject/iotex-election/types"
+)
+
+func TestQuarantine(t *testing.T) {
+	require := require.New(t)
+	now := time.Now()
+	q := newQuarantine(time.Minute)
+	require.Empty(q.failures())
+	_, ok := q.due(now)
+	require.False(ok)
+
+	q.record(200, errors.New("timeout"), now)
+	q.record(100, errors.New("invalid timestamp"), now)
+	require.NotEmpty(q.failures())
+	_, ok = q.due(now)
+	require.False(ok)
+	height, ok := q.due(now.Add(2 * time.Minute))
+	require.True(ok)
+	require.Equal(uint64(100), height)
+
+	q.record(100, errors.New("still invalid"), now.Add(2*time.Minute))
+	failures := q.failures()
+	require.Equal(2, len(failures))
+	require.Equal(uint64(100), failures[0].Height)
+	require.Equal("still invalid", failures[0].Cause)
+	require.Equal(uint32(2), failures[0].Attempts)
+	require.Equal(now, failures[0].FirstFailure)
+	require.True(failures[0].NextRetry.After(now.Add(2 * time.Minute)))
+	require.Equal(uint64(200), failures[1].Height)
+
+	q.resolve(100)
+	height, ok = q.due(now.Add(time.Hour))
+	require.True(ok)
+	require.Equal(uint64(200), height)
+	q.resolveBefore(300)
+	require.Empty(q.failures())
+}
+
+func TestStoreInconsistentResult(t *testing.T) {
+	require := require.New(t)
+	kvstore := db.NewInMemKVStore()
+	require.NoError(kvstore.Start(context.Background()))
+	ec := &committee{
+		db:            kvstore,
+		cache:         newResultCache(0, 0),
+		heightManager: newHeightManager(),
+		quarantine:    newQuarantine(0),
+		startHeight:   100,
+		nextHeight:    100,
+		interval:      10,
+	}
+	now := time.Now()
+	result := func(mintTime time.Time) *types.ElectionResult {
+		r, err := types.NewResultCalculator(mintTime, false, nil, nil, nil).Calculate()
+		require.NoError(err)
+		return r
+	}
+	require.NoError(ec.storeValidResult(100, result(now), nil))
+	require.Equal(uint64(110), ec.nextHeight)
+
+	// a result with an earlier mint time is quarantined rather than crashing the process
+	require.Error(ec.storeValidResult(110, result(now.Add(-time.Minute)), nil))
+	require.Equal(uint64(110), ec.nextHeight)
+	failures := ec.Failures()
+	require.Equal(1, len(failures))
+	require.Equal(uint64(110), failures[0].Height)
+
+	require.NoError(ec.storeValidResult(110, result(now.Add(time.Minute)), nil))
+	require.Equal(uint64(120), ec.nextHeight)
+	require.Equal(0, len(ec.Failures()))
+}
+
+type failingCarrier struct {
+	carrier.Carrier
+	fetched []uint64
+}
+
+func (c *failingCarrier) BlockTimestamp(height uint64) (time.Time, error) {
+	c.fetched = append(c.fetched, height)
+	return time.Time{}, errors.New("timeout")
+}
+
+func TestSyncSkipsQuarantinedHeight(t *testing.T) {
+	require := require.New(t)
+	c := &failingCarrier{}
+	ec := &committee{
+		carrier:               c,
+		heightManager:         newHeightManager(),
+		quarantine:            newQuarantine(time.Minute),
+		retryLimit:            3,
+		fetchInParallel:       1,
+		gravityChainBatchSize: 10,
+		startHeight:           100,
+		nextHeight:            100,
+		interval:              10,
+	}
+	now := time.Now()
+	ec.quarantine.record(100, errors.New("timeout"), now)
+	// the quarantined height is not refetched by new tips before its backoff expires
+	require.NoError(ec.Sync(200, now))
+	require.NoError(ec.Sync(210, now))
+	require.Empty(c.fetched)
+	require.Equal(uint64(100), ec.nextHeight)
+	require.Equal(uint32(1), ec.Failures()[0].Attempts)
+
+	// once the retry is due, the sync fetches the height again
+	ec.quarantine.record(100, errors.New("timeout"), now.Add(-time.Hour))
+	require.Error(ec.Sync(220, now))
+	require.Equal([]uint64{100, 100, 100}, c.fetched[:3])
+	require.Equal(uint32(3), ec.Failures()[0].Attempts)
+}
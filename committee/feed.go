@@ -0,0 +1,51 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import "sync"
+
+// heightFeed signals the subscribers when new heights are stored. A signal only tells that there are
+// new heights, which subscribers query by themselves, such that a slow subscriber never blocks syncing
+type heightFeed struct {
+	mutex       sync.Mutex
+	subscribers map[chan struct{}]struct{}
+}
+
+func newHeightFeed() *heightFeed {
+	return &heightFeed{subscribers: map[chan struct{}]struct{}{}}
+}
+
+func (f *heightFeed) subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.subscribers[ch] = struct{}{}
+	return ch, func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		delete(f.subscribers, ch)
+	}
+}
+
+func (f *heightFeed) publish() {
+	if f == nil {
+		return
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for ch := range f.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// a signal is pending
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeightFeed(t *testing.T) {
+	require := require.New(t)
+	ec := &committee{heightManager: newHeightManager(), feed: newHeightFeed()}
+	updates, unsubscribe := ec.Subscribe()
+	other, unsubscribeOther := ec.Subscribe()
+	defer unsubscribeOther()
+
+	now := time.Now()
+	require.NoError(ec.addHeight(100, now))
+	// signals are coalesced, and never block adding heights
+	require.NoError(ec.addHeight(110, now.Add(time.Second)))
+	select {
+	case <-updates:
+	default:
+		require.Fail("missing signal")
+	}
+	select {
+	case <-updates:
+		require.Fail("signals should be coalesced")
+	default:
+	}
+	require.Equal([]uint64{100, 110}, ec.HeightsSince(0, 0))
+
+	unsubscribe()
+	require.NoError(ec.addHeight(120, now.Add(2*time.Second)))
+	select {
+	case <-updates:
+		require.Fail("signal after unsubscribe")
+	default:
+	}
+	require.Equal(1, len(other))
+}
@@ -11,6 +11,7 @@
 package committee
 
 import (
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
@@ -61,6 +62,31 @@ func (m *heightManager) latestHeight() uint64 {
 	return m.heights[l-1]
 }
 
+// heightAtOrBefore returns the nearest height at or before the given one, which should not be beyond the
+// latest height
+func (m *heightManager) heightAtOrBefore(height uint64) (uint64, bool) {
+	l := len(m.heights)
+	if l == 0 || height < m.heights[0] || height > m.heights[l-1] {
+		return 0, false
+	}
+	i := sort.Search(l, func(i int) bool {
+		return m.heights[i] > height
+	})
+	return m.heights[i-1], true
+}
+
+// heightsSince returns at most limit heights from the given one
+func (m *heightManager) heightsSince(height uint64, limit int) []uint64 {
+	i := sort.Search(len(m.heights), func(i int) bool {
+		return m.heights[i] >= height
+	})
+	end := len(m.heights)
+	if limit > 0 && i+limit < end {
+		end = i + limit
+	}
+	return append([]uint64{}, m.heights[i:end]...)
+}
+
 func (m *heightManager) validate(height uint64, ts time.Time) error {
 	l := len(m.heights)
 	if l == 0 {
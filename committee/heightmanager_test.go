@@ -130,3 +130,36 @@ func TestLatestHeight(t *testing.T) {
 		require.Equal(args[i].height, hm.latestHeight())
 	}
 }
+
+func TestHeightsSince(t *testing.T) {
+	require := require.New(t)
+	hm := newHeightManager()
+	require.Equal([]uint64{}, hm.heightsSince(0, 0))
+	for _, arg := range args {
+		require.NoError(hm.add(arg.height, arg.time))
+	}
+	require.Equal([]uint64{0, 1, 2, 3, 4}, hm.heightsSince(0, 0))
+	require.Equal([]uint64{2, 3}, hm.heightsSince(2, 2))
+	require.Equal([]uint64{4}, hm.heightsSince(4, 10))
+	require.Equal([]uint64{}, hm.heightsSince(5, 10))
+}
+
+func TestHeightAtOrBefore(t *testing.T) {
+	require := require.New(t)
+	hm := newHeightManager()
+	_, ok := hm.heightAtOrBefore(0)
+	require.False(ok)
+	for _, arg := range validArgs[:1] {
+		require.NoError(hm.add(arg.height, arg.time))
+	}
+	require.NoError(hm.add(40, time.Unix(int64(1546272070), 0)))
+	for height, expected := range map[uint64]uint64{30: 30, 35: 30, 39: 30, 40: 40} {
+		h, ok := hm.heightAtOrBefore(height)
+		require.True(ok)
+		require.Equal(expected, h)
+	}
+	_, ok = hm.heightAtOrBefore(29)
+	require.False(ok)
+	_, ok = hm.heightAtOrBefore(41)
+	require.False(ok)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"sync"
+
+	"github.com/iotexproject/iotex-election/types"
+)
+
+type fetchedResult struct {
+	height uint64
+	result *types.ElectionResult
+	audit  *types.ExclusionAudit
+	err    error
+}
+
+// fetchPipeline fetches the results of heights in [start, end] with a number of workers, and hands
+// them over in height order. At most window heights are fetched ahead of the one being handed over,
+// such that the memory usage is bounded no matter how far the tip is.
+type fetchPipeline struct {
+	start    uint64
+	end      uint64
+	interval uint64
+	workers  int
+	window   int
+	fetch    func(uint64) (*types.ElectionResult, *types.ExclusionAudit, error)
+}
+
+// run feeds the fetched results to process in height order, and stops at the first error returned
+// by process
+func (p *fetchPipeline) run(
+	process func(uint64, *types.ElectionResult, *types.ExclusionAudit, error) error,
+) error {
+	if p.start > p.end {
+		return nil
+	}
+	done := make(chan struct{})
+	tokens := make(chan struct{}, p.window)
+	heights := make(chan uint64)
+	fetched := make(chan fetchedResult, p.window)
+	var wg sync.WaitGroup
+	defer func() {
+		close(done)
+		wg.Wait()
+	}()
+	wg.Add(1)
+	go func() {
+		defer func() {
+			close(heights)
+			wg.Done()
+		}()
+		for height := p.start; height <= p.end; height += p.interval {
+			select {
+			case tokens <- struct{}{}:
+			case <-done:
+				return
+			}
+			select {
+			case heights <- height:
+			case <-done:
+				return
+			}
+		}
+	}()
+	for i := 0; i < p.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for height := range heights {
+				result, audit, err := p.fetch(height)
+				select {
+				case fetched <- fetchedResult{height: height, result: result, audit: audit, err: err}:
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+	pending := map[uint64]fetchedResult{}
+	for next := p.start; next <= p.end; {
+		f := <-fetched
+		pending[f.height] = f
+		for {
+			f, exists := pending[next]
+			if !exists {
+				break
+			}
+			delete(pending, next)
+			if err := process(f.height, f.result, f.audit, f.err); err != nil {
+				return err
+			}
+			<-tokens
+			next += p.interval
+			if next > p.end {
+				break
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-election/types"
+)
+
+func TestFetchPipeline(t *testing.T) {
+	require := require.New(t)
+	var inFlight, maxInFlight int32
+	fetch := func(failure uint64) func(uint64) (*types.ElectionResult, *types.ExclusionAudit, error) {
+		return func(height uint64) (*types.ElectionResult, *types.ExclusionAudit, error) {
+			n := atomic.AddInt32(&inFlight, 1)
+			for {
+				m := atomic.LoadInt32(&maxInFlight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Duration(rand.Intn(2000)) * time.Microsecond)
+			if height == failure {
+				return nil, nil, errors.New("failed to fetch")
+			}
+			return &types.ElectionResult{}, &types.ExclusionAudit{}, nil
+		}
+	}
+	t.Run("in-order", func(t *testing.T) {
+		p := &fetchPipeline{start: 100, end: 1090, interval: 10, workers: 4, window: 6, fetch: fetch(0)}
+		heights := []uint64{}
+		require.NoError(p.run(func(height uint64, result *types.ElectionResult, _ *types.ExclusionAudit, err error) error {
+			require.NoError(err)
+			require.NotNil(result)
+			heights = append(heights, height)
+			// the results fetched but not processed are bounded by the window
+			require.True(atomic.LoadInt32(&inFlight) <= 6)
+			atomic.AddInt32(&inFlight, -1)
+			return nil
+		}))
+		require.Equal(100, len(heights))
+		for i, height := range heights {
+			require.Equal(uint64(100+10*i), height)
+		}
+		require.True(atomic.LoadInt32(&maxInFlight) <= 6)
+	})
+	t.Run("stop-at-error", func(t *testing.T) {
+		atomic.StoreInt32(&inFlight, 0)
+		p := &fetchPipeline{start: 100, end: 1090, interval: 10, workers: 4, window: 6, fetch: fetch(500)}
+		var last uint64
+		err := p.run(func(height uint64, result *types.ElectionResult, _ *types.ExclusionAudit, err error) error {
+			atomic.AddInt32(&inFlight, -1)
+			if err != nil {
+				return err
+			}
+			last = height
+			return nil
+		})
+		require.Error(err)
+		require.Equal(uint64(490), last)
+	})
+	t.Run("empty", func(t *testing.T) {
+		p := &fetchPipeline{start: 100, end: 90, interval: 10, workers: 4, window: 6, fetch: fetch(0)}
+		require.NoError(p.run(func(uint64, *types.ElectionResult, *types.ExclusionAudit, error) error {
+			return errors.New("unexpected")
+		}))
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-election/db"
+	"github.com/iotexproject/iotex-election/types"
+	"github.com/iotexproject/iotex-election/util"
+)
+
+// probationKeyPrefix is the prefix of the key to store the probation list of an epoch
+const probationKeyPrefix = "probation-"
+
+// defaultProbationIntensityRate is the score reduction in percentage of the delegates on probation
+const defaultProbationIntensityRate = 90
+
+// ProbationConfig defines the source of the probation lists and the default penalty
+type ProbationConfig struct {
+	// ListPath is the JSON file of the probation lists to store on start, see types.ReadProbationLists
+	ListPath string `yaml:"listPath"`
+	// IntensityRate is the score reduction in percentage for the lists without one, where 100 excludes
+	// the delegates on probation, and 0 stands for the default 90
+	IntensityRate uint32 `yaml:"intensityRate"`
+}
+
+func (cfg ProbationConfig) intensityRate() uint32 {
+	if cfg.IntensityRate > 0 {
+		return cfg.IntensityRate
+	}
+	return defaultProbationIntensityRate
+}
+
+func probationKey(epoch uint64) []byte {
+	return append([]byte(probationKeyPrefix), util.Uint64ToBytes(epoch)...)
+}
+
+// loadProbationLists stores the probation lists in the file of the config
+func (ec *committee) loadProbationLists() error {
+	if ec.probation.ListPath == "" {
+		return nil
+	}
+	file, err := os.Open(ec.probation.ListPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to open probation lists")
+	}
+	defer file.Close()
+	lists, err := types.ReadProbationLists(file)
+	if err != nil {
+		return err
+	}
+	for _, list := range lists {
+		if err := ec.PutProbationList(list); err != nil {
+			return err
+		}
+	}
+	zap.L().Info("loaded probation lists", zap.Int("lists", len(lists)))
+
+	return nil
+}
+
+func (ec *committee) PutProbationList(list *types.ProbationList) error {
+	if ec.replica.ReadOnly {
+		return errors.New("cannot put probation list into a read-only committee")
+	}
+	if list.IntensityRate == 0 {
+		list = &types.ProbationList{
+			Epoch:         list.Epoch,
+			IntensityRate: ec.probation.intensityRate(),
+			Delegates:     list.Delegates,
+		}
+	}
+	if err := list.Validate(); err != nil {
+		return err
+	}
+	data, err := list.Serialize()
+	if err != nil {
+		return err
+	}
+	return ec.db.Put(probationKey(list.Epoch), data)
+}
+
+func (ec *committee) ProbationListByEpoch(epoch uint64) (*types.ProbationList, error) {
+	data, err := ec.db.Get(probationKey(epoch))
+	switch errors.Cause(err) {
+	case nil:
+		list := &types.ProbationList{}
+		if err := list.Deserialize(data); err != nil {
+			return nil, err
+		}
+		return list, nil
+	case db.ErrNotExist:
+		return &types.ProbationList{Epoch: epoch, IntensityRate: ec.probation.intensityRate()}, nil
+	default:
+		return nil, err
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-election/db"
+	"github.com/iotexproject/iotex-election/types"
+)
+
+func TestProbationLists(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "probation")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	name := hex.EncodeToString([]byte("alice0000000"))
+	path := filepath.Join(dir, "probation.json")
+	require.NoError(ioutil.WriteFile(path, []byte(`[
+		{"epoch": 1, "intensityRate": 100, "delegates": ["`+name+`"]},
+		{"epoch": 2, "delegates": ["`+name+`"]}
+	]`), 0644))
+	kvstore := db.NewInMemKVStore()
+	require.NoError(kvstore.Start(ctx))
+	ec := &committee{
+		db:        newEnvelopeStore(kvstore),
+		probation: ProbationConfig{ListPath: path, IntensityRate: 80},
+	}
+	require.NoError(ec.loadProbationLists())
+
+	list, err := ec.ProbationListByEpoch(1)
+	require.NoError(err)
+	require.Equal(uint32(100), list.IntensityRate)
+	require.Equal([][]byte{[]byte("alice0000000")}, list.Delegates)
+	// the default intensity rate applies to the lists without one
+	list, err = ec.ProbationListByEpoch(2)
+	require.NoError(err)
+	require.Equal(uint32(80), list.IntensityRate)
+	list, err = ec.ProbationListByEpoch(3)
+	require.NoError(err)
+	require.Equal(uint64(3), list.Epoch)
+	require.Equal(0, len(list.Delegates))
+
+	require.NoError(ec.PutProbationList(&types.ProbationList{Epoch: 3, IntensityRate: 50}))
+	list, err = ec.ProbationListByEpoch(3)
+	require.NoError(err)
+	require.Equal(uint32(50), list.IntensityRate)
+	require.Error(ec.PutProbationList(&types.ProbationList{Epoch: 3, IntensityRate: 101}))
+
+	ec.replica.ReadOnly = true
+	require.Error(ec.PutProbationList(&types.ProbationList{Epoch: 4}))
+}
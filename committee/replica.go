@@ -0,0 +1,213 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-election/db"
+	"github.com/iotexproject/iotex-election/util"
+)
+
+const (
+	// defaultReplicaPollInterval is the interval between two reloads of a read-only committee if
+	// none is configured
+	defaultReplicaPollInterval = 5 * time.Second
+	// defaultSnapshotInterval is the interval between two snapshots if none is configured, which
+	// along with the poll interval keeps the replicas well inside the active window
+	defaultSnapshotInterval = 15 * time.Second
+)
+
+// ErrReadOnly indicates that the operation is not supported by a read-only committee
+var ErrReadOnly = errors.New("committee is read-only")
+
+// ReplicaConfig defines the config of sharing the results among committees. A single writer
+// committee syncs with gravity chain and optionally writes snapshots of its db, and read-only
+// committees serve the results from the db, e.g., a snapshot on a shared volume. The live db of the
+// writer is locked by it, so the read-only committees have to read a snapshot. The freshness of a
+// replica is the last update time the writer stamps next to the snapshot, hence the snapshot
+// interval should be well inside the active window of Status.
+type ReplicaConfig struct {
+	// ReadOnly makes the committee serve the results from db without syncing with gravity chain
+	ReadOnly bool `yaml:"readOnly"`
+	// PollInterval is the interval of a read-only committee to check the snapshot, which costs a stat
+	// of the snapshot and a read of its stamp, while the snapshot is only reopened once replaced
+	PollInterval time.Duration `yaml:"pollInterval"`
+	// SnapshotPath is the path of the snapshot the writer committee writes its db to
+	SnapshotPath string `yaml:"snapshotPath"`
+	// SnapshotInterval is the interval between two checks of the writer, which copies the whole db to
+	// the snapshot only if a new height has been stored since last copy, and otherwise rewrites the
+	// 8-byte stamp of the snapshot. The full copy is as large as the db, once per stored height.
+	SnapshotInterval time.Duration `yaml:"snapshotInterval"`
+}
+
+func (cfg ReplicaConfig) validate(kvstore db.KVStore) error {
+	if cfg.SnapshotPath == "" {
+		return nil
+	}
+	if cfg.ReadOnly {
+		return errors.New("a read-only committee cannot write snapshots")
+	}
+	if _, ok := kvstore.(db.Snapshotter); !ok {
+		return errors.New("db does not support snapshot")
+	}
+	if cfg.snapshotInterval() > activeWindow/2 {
+		return errors.Errorf(
+			"snapshot interval %s is too long for the replicas to stay active within %s",
+			cfg.snapshotInterval(),
+			activeWindow,
+		)
+	}
+	return nil
+}
+
+func (cfg ReplicaConfig) pollInterval() time.Duration {
+	if cfg.PollInterval > 0 {
+		return cfg.PollInterval
+	}
+	return defaultReplicaPollInterval
+}
+
+func (cfg ReplicaConfig) snapshotInterval() time.Duration {
+	if cfg.SnapshotInterval > 0 {
+		return cfg.SnapshotInterval
+	}
+	return defaultSnapshotInterval
+}
+
+// load loads the heights stored in db since last load
+func (ec *committee) load() error {
+	if _, err := ec.checkSchemaVersion(); err != nil {
+		return err
+	}
+	if data, err := ec.db.Get(db.NextHeightKey); err == nil {
+		nextHeight := util.BytesToUint64(data)
+		for height := ec.nextHeight; height < nextHeight; height += ec.interval {
+			zap.L().Info("loading", zap.Uint64("height", height))
+			r, err := ec.readResult(height)
+			if err != nil {
+				return err
+			}
+			ec.cache.insert(height, r)
+			if err := ec.addHeight(height, r.MintTime()); err != nil {
+				return err
+			}
+			ec.nextHeight = height + ec.interval
+		}
+	}
+	if data, err := ec.db.Get(db.PrunedHeightKey); err == nil {
+		ec.prunedHeight = util.BytesToUint64(data)
+	}
+	if data, err := ec.db.Get(db.LastUpdateTimeKey); err == nil {
+		ec.updateTimestamp(time.Unix(int64(util.BytesToUint64(data)), 0))
+	}
+
+	return nil
+}
+
+func (ec *committee) startWatching() {
+	go func() {
+		ticker := time.NewTicker(ec.replica.pollInterval())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ec.stop:
+				return
+			case <-ticker.C:
+				if err := ec.reload(); err != nil {
+					zap.L().Error("failed to reload db", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// reload picks up the heights stored by the writer since last load
+func (ec *committee) reload() error {
+	ec.mutex.Lock()
+	defer ec.mutex.Unlock()
+	if r, ok := ec.rawDB().(db.Reloader); ok {
+		if err := r.Reload(context.Background()); err != nil {
+			return err
+		}
+		ts, err := r.Stamp()
+		switch errors.Cause(err) {
+		case nil:
+			ec.updateTimestamp(ts)
+		case db.ErrNotExist:
+			// the snapshot is written by a writer without stamps
+		default:
+			return err
+		}
+	}
+	prunedHeight := ec.prunedHeight
+	if err := ec.load(); err != nil {
+		return err
+	}
+	// drop the full results cached before the writer pruned them
+	for _, height := range ec.heightManager.heights {
+		if height >= prunedHeight && height < ec.prunedHeight {
+			ec.cache.remove(height)
+		}
+	}
+
+	return nil
+}
+
+func (ec *committee) startSnapshotting() {
+	if ec.replica.SnapshotPath == "" {
+		return
+	}
+	snapshotter := ec.rawDB().(db.Snapshotter)
+	go func() {
+		ticker := time.NewTicker(ec.replica.snapshotInterval())
+		defer ticker.Stop()
+		var snapshotHeight []byte
+		for {
+			snapshotHeight = ec.snapshot(snapshotter, snapshotHeight)
+			select {
+			case <-ec.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// snapshot copies the db to the snapshot if the next height has advanced since the last copy, and
+// stamps the snapshot with the last update time if it is up to date. It returns the next height of
+// the snapshot.
+func (ec *committee) snapshot(snapshotter db.Snapshotter, snapshotHeight []byte) []byte {
+	nextHeight, err := ec.db.Get(db.NextHeightKey)
+	if err != nil {
+		// nothing has been stored yet
+		return snapshotHeight
+	}
+	if !bytes.Equal(nextHeight, snapshotHeight) {
+		if err := snapshotter.Snapshot(ec.replica.SnapshotPath); err != nil {
+			zap.L().Error("failed to write snapshot", zap.Error(err))
+			return snapshotHeight
+		}
+		snapshotHeight = nextHeight
+	}
+	if ts := atomic.LoadInt64(&ec.lastUpdateTimestamp); ts != 0 {
+		if err := snapshotter.Touch(ec.replica.SnapshotPath, time.Unix(ts, 0)); err != nil {
+			zap.L().Error("failed to stamp snapshot", zap.Error(err))
+		}
+	}
+	return snapshotHeight
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-election/db"
+	"github.com/iotexproject/iotex-election/types"
+)
+
+func TestReadOnlyReplica(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "replica")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	snapshotPath := filepath.Join(dir, "snapshot.db")
+
+	store := db.NewKVStoreWithNamespaceWrapper(
+		Namespace,
+		db.NewBoltDB(db.Config{NumOfRetries: 3, DBPath: filepath.Join(dir, "writer.db")}),
+	)
+	require.NoError(store.Start(ctx))
+	defer store.Stop(ctx)
+	writer := &committee{
+		db:            store,
+		cache:         newResultCache(0, 0),
+		heightManager: newHeightManager(),
+		quarantine:    newQuarantine(0),
+		replica:       ReplicaConfig{SnapshotPath: snapshotPath},
+		startHeight:   100,
+		nextHeight:    100,
+		interval:      10,
+	}
+	require.NoError(writer.migrateResultFormat())
+	now := time.Now()
+	storeAt := func(height uint64, mintTime time.Time) {
+		r, err := types.NewResultCalculator(mintTime, false, nil, nil, nil).Calculate()
+		require.NoError(err)
+		require.NoError(writer.storeValidResult(height, r, nil))
+	}
+	storeAt(100, now.Add(-time.Hour))
+	writer.markSynced(now.Add(-50 * time.Minute))
+	snapshotter := store.(db.Snapshotter)
+	snapshotHeight := writer.snapshot(snapshotter, nil)
+	require.NotNil(snapshotHeight)
+	snapshot, err := os.Stat(snapshotPath)
+	require.NoError(err)
+
+	_, err = NewCommittee(store, Config{Replica: ReplicaConfig{ReadOnly: true, SnapshotPath: snapshotPath}})
+	require.Error(err)
+	_, err = NewCommittee(store, Config{Replica: ReplicaConfig{SnapshotPath: snapshotPath, SnapshotInterval: time.Minute}})
+	require.Contains(err.Error(), "snapshot interval")
+	replica, err := NewCommitteeWithKVStoreWithNamespace(
+		db.NewBoltDB(db.Config{DBPath: snapshotPath, ReadOnly: true}),
+		Config{
+			GravityChainStartHeight:    100,
+			GravityChainHeightInterval: 10,
+			VoteThreshold:              "0",
+			ScoreThreshold:             "0",
+			SelfStakingThreshold:       "0",
+			Replica:                    ReplicaConfig{ReadOnly: true},
+		},
+	)
+	require.NoError(err)
+	require.NoError(replica.Start(ctx))
+	defer replica.Stop(ctx)
+	require.Equal(uint64(100), replica.LatestHeight())
+	_, err = replica.FetchResultByHeight(100)
+	require.Equal(ErrReadOnly, err)
+
+	// the snapshot is only stamped rather than copied again if no new height has been stored
+	writer.markSynced(now.Add(-45 * time.Minute))
+	require.Equal(snapshotHeight, writer.snapshot(snapshotter, snapshotHeight))
+	unchanged, err := os.Stat(snapshotPath)
+	require.NoError(err)
+	require.True(os.SameFile(snapshot, unchanged))
+	require.NoError(replica.(*committee).reload())
+	require.Equal(uint64(100), replica.LatestHeight())
+	require.Equal(now.Add(-45*time.Minute).Unix(), replica.(*committee).lastUpdateTimestamp)
+
+	storeAt(110, now.Add(-40*time.Minute))
+	writer.markSynced(now.Add(-30 * time.Minute))
+	writer.snapshot(snapshotter, snapshotHeight)
+	replaced, err := os.Stat(snapshotPath)
+	require.NoError(err)
+	require.False(os.SameFile(snapshot, replaced))
+	require.NoError(replica.(*committee).reload())
+	require.Equal(uint64(110), replica.LatestHeight())
+	result, err := replica.ResultByHeight(110)
+	require.NoError(err)
+	require.Equal(now.Add(-40*time.Minute).Unix(), result.MintTime().Unix())
+	height, err := replica.HeightByTime(now.Add(-35 * time.Minute))
+	require.NoError(err)
+	require.Equal(uint64(110), height)
+	stats, err := replica.StatisticsByHeight(110)
+	require.NoError(err)
+	require.Equal(uint32(0), stats.TotalVoters)
+	_, err = replica.StatisticsByHeight(120)
+	require.Equal(db.ErrNotExist, err)
+}
@@ -2,7 +2,7 @@
 // This program is free software: you can redistribute it and/or modify it under the terms of the
 // GNU General Public License as published by the Free Software Foundation, either version 3 of
 // the License, or (at your option) any later version.
-// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; 
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
 // without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
 // the GNU General Public License for more details.
 // You should have received a copy of the GNU General Public License along with this program. If
@@ -11,43 +11,138 @@
 package committee
 
 import (
+	"container/list"
+	"sync"
+
 	"github.com/iotexproject/iotex-election/types"
 )
 
+// defaultCacheMemoryLimit is the memory budget of the result cache if none is configured
+const defaultCacheMemoryLimit = uint64(512 << 20)
+
+// CacheStats defines the statistics of the result cache
+type CacheStats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Entries     uint32
+	Size        uint64
+	MemoryLimit uint64
+}
+
+type cacheEntry struct {
+	height uint64
+	result *types.ElectionResult
+	size   uint64
+}
+
+// resultCache is a LRU cache of election results bounded by the estimated memory size of the
+// results, and optionally by the number of entries as well
 type resultCache struct {
-	size    uint32
-	results []*types.ElectionResult
-	heights []uint64
-	index   map[uint64]int
-	cursor  int
+	maxEntries  uint32
+	memoryLimit uint64
+	size        uint64
+	lru         *list.List
+	index       map[uint64]*list.Element
+	hits        uint64
+	misses      uint64
+	evictions   uint64
+	mutex       sync.Mutex
 }
 
-func newResultCache(size uint32) *resultCache {
+func newResultCache(maxEntries uint32, memoryLimit uint64) *resultCache {
+	if memoryLimit == 0 {
+		memoryLimit = defaultCacheMemoryLimit
+	}
 	return &resultCache{
-		size:    size,
-		results: make([]*types.ElectionResult, size),
-		heights: make([]uint64, size),
-		index:   map[uint64]int{},
-		cursor:  0,
+		maxEntries:  maxEntries,
+		memoryLimit: memoryLimit,
+		lru:         list.New(),
+		index:       map[uint64]*list.Element{},
 	}
 }
 
+// insert puts a result into the cache as the most recently used one
 func (c *resultCache) insert(height uint64, r *types.ElectionResult) {
-	if i, exists := c.index[height]; exists {
-		c.results[i] = r
-		return
-	}
-	delete(c.index, c.heights[c.cursor])
-	c.results[c.cursor] = r
-	c.heights[c.cursor] = height
-	c.index[height] = c.cursor
-	c.cursor = (c.cursor + 1) % int(c.size)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.put(height, r, true)
+}
+
+// admit puts a result into the cache as the least recently used one, such that a result loaded
+// for a one-off query of an old height will be evicted before the hot ones
+func (c *resultCache) admit(height uint64, r *types.ElectionResult) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.put(height, r, false)
 }
 
 func (c *resultCache) get(height uint64) *types.ElectionResult {
-	i, exists := c.index[height]
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	e, exists := c.index[height]
 	if !exists {
+		c.misses++
 		return nil
 	}
-	return c.results[i]
+	c.hits++
+	c.lru.MoveToFront(e)
+
+	return e.Value.(*cacheEntry).result
+}
+
+func (c *resultCache) remove(height uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if e, exists := c.index[height]; exists {
+		c.removeElement(e)
+	}
+}
+
+func (c *resultCache) stats() CacheStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return CacheStats{
+		Hits:        c.hits,
+		Misses:      c.misses,
+		Evictions:   c.evictions,
+		Entries:     uint32(c.lru.Len()),
+		Size:        c.size,
+		MemoryLimit: c.memoryLimit,
+	}
+}
+
+func (c *resultCache) put(height uint64, r *types.ElectionResult, hot bool) {
+	if e, exists := c.index[height]; exists {
+		c.removeElement(e)
+	}
+	entry := &cacheEntry{
+		height: height,
+		result: r,
+		size:   r.EstimatedSize(),
+	}
+	if entry.size > c.memoryLimit {
+		// the result will never fit into the cache
+		return
+	}
+	for c.lru.Len() > 0 && (c.size+entry.size > c.memoryLimit || c.full()) {
+		c.removeElement(c.lru.Back())
+		c.evictions++
+	}
+	if hot {
+		c.index[height] = c.lru.PushFront(entry)
+	} else {
+		c.index[height] = c.lru.PushBack(entry)
+	}
+	c.size += entry.size
+}
+
+func (c *resultCache) full() bool {
+	return c.maxEntries > 0 && uint32(c.lru.Len()) >= c.maxEntries
+}
+
+func (c *resultCache) removeElement(e *list.Element) {
+	entry := c.lru.Remove(e).(*cacheEntry)
+	delete(c.index, entry.height)
+	c.size -= entry.size
 }
@@ -0,0 +1,79 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-election/types"
+)
+
+func TestResultCache(t *testing.T) {
+	require := require.New(t)
+	r := types.NewElectionResultForTest(time.Now())
+	size := r.EstimatedSize()
+	require.True(size > 0)
+
+	t.Run("evict-least-recently-used", func(t *testing.T) {
+		cache := newResultCache(0, 3*size)
+		cache.insert(1, r)
+		cache.insert(2, r)
+		cache.insert(3, r)
+		require.NotNil(cache.get(1))
+		cache.insert(4, r)
+		require.Nil(cache.get(2))
+		require.NotNil(cache.get(1))
+		require.NotNil(cache.get(3))
+		require.NotNil(cache.get(4))
+		stats := cache.stats()
+		require.Equal(uint64(4), stats.Hits)
+		require.Equal(uint64(1), stats.Misses)
+		require.Equal(uint64(1), stats.Evictions)
+		require.Equal(uint32(3), stats.Entries)
+		require.Equal(3*size, stats.Size)
+	})
+	t.Run("evict-admitted-first", func(t *testing.T) {
+		cache := newResultCache(0, 3*size)
+		cache.insert(10, r)
+		cache.insert(11, r)
+		cache.admit(1, r)
+		cache.insert(12, r)
+		require.Nil(cache.get(1))
+		require.NotNil(cache.get(10))
+		require.NotNil(cache.get(11))
+		require.NotNil(cache.get(12))
+	})
+	t.Run("bounded-by-entries", func(t *testing.T) {
+		cache := newResultCache(2, 10*size)
+		cache.insert(1, r)
+		cache.insert(2, r)
+		cache.insert(3, r)
+		require.Nil(cache.get(1))
+		require.Equal(uint32(2), cache.stats().Entries)
+	})
+	t.Run("replace-and-remove", func(t *testing.T) {
+		cache := newResultCache(0, 2*size)
+		cache.insert(1, r)
+		cache.insert(1, r)
+		require.Equal(size, cache.stats().Size)
+		cache.remove(1)
+		require.Nil(cache.get(1))
+		require.Equal(uint64(0), cache.stats().Size)
+	})
+	t.Run("too-large", func(t *testing.T) {
+		cache := newResultCache(0, size-1)
+		cache.insert(1, r)
+		require.Nil(cache.get(1))
+	})
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-election/db"
+	"github.com/iotexproject/iotex-election/types"
+	"github.com/iotexproject/iotex-election/util"
+)
+
+// defaultPruneInterval is the interval between two pruning rounds if none is configured
+const defaultPruneInterval = time.Hour
+
+// ErrPruned indicates that the votes of the requested result have been pruned
+var ErrPruned = errors.New("votes of the result have been pruned")
+
+// RetentionConfig defines the policy of keeping the stored results
+type RetentionConfig struct {
+	// FullResultHeights is the number of latest heights whose full results are kept, and results
+	// of older heights are pruned to summaries without votes. 0 means keeping everything.
+	FullResultHeights uint64 `yaml:"fullResultHeights"`
+	// KeepDailyFullResult keeps the full result of the first height of each day (in UTC) beyond
+	// the latest FullResultHeights heights
+	KeepDailyFullResult bool `yaml:"keepDailyFullResult"`
+	// PruneInterval is the interval between two pruning rounds
+	PruneInterval time.Duration `yaml:"pruneInterval"`
+}
+
+func (cfg RetentionConfig) enabled() bool {
+	return cfg.FullResultHeights > 0
+}
+
+func (cfg RetentionConfig) interval() time.Duration {
+	if cfg.PruneInterval > 0 {
+		return cfg.PruneInterval
+	}
+	return defaultPruneInterval
+}
+
+// heightsToPrune returns the heights to prune among the sorted heights and their mint times,
+// skipping those lower than prunedHeight which have been checked in previous rounds. It returns
+// the new watermark as well, below which all heights have been checked.
+func (cfg RetentionConfig) heightsToPrune(
+	heights []uint64,
+	times []time.Time,
+	prunedHeight uint64,
+) ([]uint64, uint64) {
+	if !cfg.enabled() || uint64(len(heights)) <= cfg.FullResultHeights {
+		return nil, prunedHeight
+	}
+	cutoff := len(heights) - int(cfg.FullResultHeights)
+	retval := []uint64{}
+	for i := 0; i < cutoff; i++ {
+		if heights[i] < prunedHeight {
+			continue
+		}
+		if cfg.KeepDailyFullResult && (i == 0 || !sameDay(times[i-1], times[i])) {
+			continue
+		}
+		retval = append(retval, heights[i])
+	}
+
+	return retval, heights[cutoff]
+}
+
+func sameDay(t1 time.Time, t2 time.Time) bool {
+	y1, m1, d1 := t1.UTC().Date()
+	y2, m2, d2 := t2.UTC().Date()
+
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
+
+func (ec *committee) startPruning() {
+	if !ec.retention.enabled() {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(ec.retention.interval())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ec.stop:
+				return
+			case <-ticker.C:
+				if err := ec.prune(); err != nil {
+					zap.L().Error("failed to prune results", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+func (ec *committee) prune() error {
+	ec.mutex.RLock()
+	heights, watermark := ec.retention.heightsToPrune(
+		ec.heightManager.heights,
+		ec.heightManager.times,
+		ec.prunedHeight,
+	)
+	ec.mutex.RUnlock()
+	for _, height := range heights {
+		if err := ec.pruneResult(height); err != nil {
+			return errors.Wrapf(err, "failed to prune result of height %d", height)
+		}
+	}
+	ec.mutex.Lock()
+	defer ec.mutex.Unlock()
+	if watermark <= ec.prunedHeight {
+		return nil
+	}
+	if err := ec.db.Put(db.PrunedHeightKey, util.Uint64ToBytes(watermark)); err != nil {
+		return err
+	}
+	ec.prunedHeight = watermark
+	zap.L().Info("pruned results", zap.Int("count", len(heights)), zap.Uint64("watermark", watermark))
+
+	return nil
+}
+
+func (ec *committee) pruneResult(height uint64) error {
+	ec.mutex.Lock()
+	defer ec.mutex.Unlock()
+	result, err := ec.readResult(height)
+	if err != nil {
+		return err
+	}
+	if result.Pruned() {
+		return nil
+	}
+	var data []byte
+	if _, err := ec.db.Get(ec.commitmentKey(height)); err != nil {
+		// keep the commitment of the full result, which cannot be calculated after pruning
+		commitment, err := result.Commitment()
+		if err != nil {
+			return err
+		}
+		if data, err = commitment.Serialize(); err != nil {
+			return err
+		}
+		if err := ec.db.Put(ec.commitmentKey(height), data); err != nil {
+			return err
+		}
+	}
+	// the votes of the next height cannot be reconstructed from the summary
+	if err := ec.rekeyDependent(height); err != nil {
+		return err
+	}
+	if err := ec.putResult(height, result.Summary(), nil); err != nil {
+		return err
+	}
+	if data, err := ec.db.Get(ec.auditKey(height)); err == nil {
+		audit := &types.ExclusionAudit{}
+		if err := audit.Deserialize(data); err != nil {
+			return err
+		}
+		if data, err = audit.Summary().Serialize(); err != nil {
+			return err
+		}
+		if err := ec.db.Put(ec.auditKey(height), data); err != nil {
+			return err
+		}
+	}
+	ec.cache.remove(height)
+
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeightsToPrune(t *testing.T) {
+	require := require.New(t)
+	day := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	heights := []uint64{100, 200, 300, 400, 500, 600}
+	times := []time.Time{
+		day.Add(time.Hour),
+		day.Add(2 * time.Hour),
+		day.Add(25 * time.Hour),
+		day.Add(26 * time.Hour),
+		day.Add(27 * time.Hour),
+		day.Add(49 * time.Hour),
+	}
+	t.Run("disabled", func(t *testing.T) {
+		toPrune, watermark := RetentionConfig{}.heightsToPrune(heights, times, 0)
+		require.Equal(0, len(toPrune))
+		require.Equal(uint64(0), watermark)
+	})
+	t.Run("window-larger-than-heights", func(t *testing.T) {
+		cfg := RetentionConfig{FullResultHeights: 6}
+		toPrune, watermark := cfg.heightsToPrune(heights, times, 0)
+		require.Equal(0, len(toPrune))
+		require.Equal(uint64(0), watermark)
+	})
+	t.Run("prune-all-beyond-window", func(t *testing.T) {
+		cfg := RetentionConfig{FullResultHeights: 2}
+		toPrune, watermark := cfg.heightsToPrune(heights, times, 0)
+		require.Equal([]uint64{100, 200, 300, 400}, toPrune)
+		require.Equal(uint64(500), watermark)
+		toPrune, watermark = cfg.heightsToPrune(heights, times, 300)
+		require.Equal([]uint64{300, 400}, toPrune)
+		require.Equal(uint64(500), watermark)
+	})
+	t.Run("keep-daily", func(t *testing.T) {
+		cfg := RetentionConfig{FullResultHeights: 1, KeepDailyFullResult: true}
+		toPrune, watermark := cfg.heightsToPrune(heights, times, 0)
+		require.Equal([]uint64{200, 400, 500}, toPrune)
+		require.Equal(uint64(600), watermark)
+	})
+}
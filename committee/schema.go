@@ -0,0 +1,282 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"bytes"
+	"math"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-election/db"
+	pb "github.com/iotexproject/iotex-election/pb/election"
+	"github.com/iotexproject/iotex-election/types"
+	"github.com/iotexproject/iotex-election/util"
+)
+
+// SchemaVersion is the version of the db schema written by this build
+const SchemaVersion = uint32(3)
+
+// ErrSchemaDowngrade indicates that the db has been written by a newer build
+var ErrSchemaDowngrade = errors.New("db schema is newer than supported")
+
+// schemaVersionKey is the key of the schema version of the db
+var schemaVersionKey = []byte("schema-version")
+
+// envelopeMagic prefixes every enveloped record, which can never be the first bytes of a legacy
+// record, i.e., a serialized protobuf message or a big endian integer below math.MaxUint64
+var envelopeMagic = []byte{0xff, 'E', 'V'}
+
+// migration upgrades the db to version
+type migration struct {
+	version     uint32
+	description string
+	migrate     func(*committee) error
+}
+
+// migrations are the migrations to upgrade the db, in the order of versions
+var migrations = []migration{
+	{
+		version:     1,
+		description: "store results as compressed keyframes and deltas",
+		migrate:     (*committee).migrateResultFormat,
+	},
+	{
+		version:     2,
+		description: "wrap records in versioned envelopes",
+		migrate:     (*committee).migrateEnvelopes,
+	},
+	{
+		version:     3,
+		description: "compute the statistics of results stored without them",
+		migrate:     (*committee).migrateStatistics,
+	},
+}
+
+// envelopeStore wraps the records put into the kv store with the schema version, and unwraps them on
+// get. Records put without an envelope are treated as version 0.
+type envelopeStore struct {
+	db.KVStore
+}
+
+func newEnvelopeStore(kvstore db.KVStore) *envelopeStore {
+	return &envelopeStore{KVStore: kvstore}
+}
+
+func (s *envelopeStore) Put(key []byte, value []byte) error {
+	data, err := proto.Marshal(&pb.Envelope{Version: SchemaVersion, Payload: value})
+	if err != nil {
+		return err
+	}
+	return s.KVStore.Put(key, append(append([]byte{}, envelopeMagic...), data...))
+}
+
+func (s *envelopeStore) Get(key []byte) ([]byte, error) {
+	value, _, err := s.getWithVersion(key)
+	return value, err
+}
+
+// getWithVersion returns the record and the schema version it is written in
+func (s *envelopeStore) getWithVersion(key []byte) ([]byte, uint32, error) {
+	data, err := s.KVStore.Get(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !bytes.HasPrefix(data, envelopeMagic) {
+		return data, 0, nil
+	}
+	envelope := &pb.Envelope{}
+	if err := proto.Unmarshal(data[len(envelopeMagic):], envelope); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to unwrap record")
+	}
+	if envelope.Version > SchemaVersion {
+		return nil, 0, errors.Wrapf(ErrSchemaDowngrade, "record of version %d", envelope.Version)
+	}
+	return envelope.Payload, envelope.Version, nil
+}
+
+// rawDB returns the kv store underneath the envelopes
+func (ec *committee) rawDB() db.KVStore {
+	if s, ok := ec.db.(*envelopeStore); ok {
+		return s.KVStore
+	}
+	return ec.db
+}
+
+// schemaVersion returns the schema version of the db, which is inferred from the records for the
+// dbs written before the version is stored
+func (ec *committee) schemaVersion() (uint32, error) {
+	data, err := ec.db.Get(schemaVersionKey)
+	switch errors.Cause(err) {
+	case nil:
+		return uint32(util.BytesToUint64(data)), nil
+	case db.ErrNotExist:
+		if data, err := ec.db.Get(resultFormatKey); err == nil && util.BytesToUint64(data) == math.MaxUint64 {
+			return 1, nil
+		}
+		return 0, nil
+	default:
+		return 0, err
+	}
+}
+
+// checkSchemaVersion refuses a db written by a newer build
+func (ec *committee) checkSchemaVersion() (uint32, error) {
+	version, err := ec.schemaVersion()
+	if err != nil {
+		return 0, err
+	}
+	if version > SchemaVersion {
+		return 0, errors.Wrapf(ErrSchemaDowngrade, "db of version %d, supporting %d", version, SchemaVersion)
+	}
+	return version, nil
+}
+
+// migrate upgrades the db to SchemaVersion by running the migrations of newer versions in order
+func (ec *committee) migrate() error {
+	version, err := ec.checkSchemaVersion()
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if m.version <= version {
+			continue
+		}
+		zap.L().Info(
+			"migrating db",
+			zap.Uint32("from", version),
+			zap.Uint32("to", m.version),
+			zap.String("migration", m.description),
+		)
+		start := time.Now()
+		if err := m.migrate(ec); err != nil {
+			return errors.Wrapf(err, "failed to migrate db to version %d", m.version)
+		}
+		if err := ec.db.Put(schemaVersionKey, util.Uint64ToBytes(uint64(m.version))); err != nil {
+			return err
+		}
+		version = m.version
+		zap.L().Info("migrated db", zap.Uint32("version", version), zap.Duration("duration", time.Since(start)))
+	}
+
+	return nil
+}
+
+// storedHeights returns the range of the heights stored in db
+func (ec *committee) storedHeights() (uint64, uint64) {
+	nextHeight := ec.startHeight
+	if data, err := ec.db.Get(db.NextHeightKey); err == nil {
+		nextHeight = util.BytesToUint64(data)
+	}
+	return ec.startHeight, nextHeight
+}
+
+// logProgress logs the progress of a migration every 1000 heights and on the last height
+func (ec *committee) logProgress(description string, height uint64, start uint64, end uint64) {
+	if ec.interval == 0 {
+		return
+	}
+	done := (height-start)/ec.interval + 1
+	total := (end - start + ec.interval - 1) / ec.interval
+	if done%1000 != 0 && height+ec.interval < end {
+		return
+	}
+	zap.L().Info(
+		"migration progress",
+		zap.String("migration", description),
+		zap.Uint64("height", height),
+		zap.Uint64("done", done),
+		zap.Uint64("total", total),
+	)
+}
+
+func (ec *committee) migrateEnvelopes() error {
+	keys := [][]byte{
+		db.NextHeightKey,
+		db.PrunedHeightKey,
+		db.LastUpdateTimeKey,
+		resultFormatKey,
+	}
+	start, end := ec.storedHeights()
+	for height := start; height < end; height += ec.interval {
+		for _, key := range [][]byte{
+			ec.dbKey(height),
+			ec.commitmentKey(height),
+			ec.auditKey(height),
+			ec.statisticsKey(height),
+		} {
+			if err := ec.rewrap(key); err != nil {
+				return err
+			}
+		}
+		ec.logProgress("envelopes", height, start, end)
+	}
+	for _, key := range keys {
+		if err := ec.rewrap(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rewrap puts a record without an envelope back with an envelope
+func (ec *committee) rewrap(key []byte) error {
+	data, err := ec.rawDB().Get(key)
+	switch errors.Cause(err) {
+	case nil:
+		if bytes.HasPrefix(data, envelopeMagic) {
+			return nil
+		}
+		return ec.db.Put(key, data)
+	case db.ErrNotExist:
+		return nil
+	default:
+		return err
+	}
+}
+
+func (ec *committee) migrateStatistics() error {
+	start, end := ec.storedHeights()
+	for height := start; height < end; height += ec.interval {
+		_, err := ec.db.Get(ec.statisticsKey(height))
+		switch errors.Cause(err) {
+		case nil:
+			continue
+		case db.ErrNotExist:
+		default:
+			return err
+		}
+		result, err := ec.resultByHeight(height)
+		if err != nil {
+			return err
+		}
+		if !result.Pruned() {
+			stats, err := types.NewStatistics(result)
+			if err != nil {
+				return err
+			}
+			data, err := stats.Serialize()
+			if err != nil {
+				return err
+			}
+			if err := ec.db.Put(ec.statisticsKey(height), data); err != nil {
+				return err
+			}
+		}
+		ec.logProgress("statistics", height, start, end)
+	}
+
+	return nil
+}
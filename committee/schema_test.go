@@ -0,0 +1,143 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-election/db"
+	pb "github.com/iotexproject/iotex-election/pb/election"
+	"github.com/iotexproject/iotex-election/types"
+	"github.com/iotexproject/iotex-election/util"
+)
+
+func TestSchemaMigration(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "schema")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	raw := db.NewKVStoreWithNamespaceWrapper(
+		Namespace,
+		db.NewBoltDB(db.Config{NumOfRetries: 3, DBPath: filepath.Join(dir, "schema.db")}),
+	)
+	require.NoError(raw.Start(ctx))
+	defer raw.Stop(ctx)
+	ec := &committee{
+		db:                   newEnvelopeStore(raw),
+		cache:                newResultCache(0, 1),
+		heightManager:        newHeightManager(),
+		quarantine:           newQuarantine(0),
+		startHeight:          100,
+		nextHeight:           100,
+		interval:             10,
+		keyframeInterval:     4,
+		voteThreshold:        big.NewInt(0),
+		scoreThreshold:       big.NewInt(0),
+		selfStakingThreshold: big.NewInt(0),
+	}
+	// a db of version 0, with results written without envelopes
+	now := time.Now()
+	candidates := []*types.Candidate{
+		types.NewCandidate([]byte("alice0000000"), []byte("a"), nil, nil, 1),
+		types.NewCandidate([]byte("bob000000000"), []byte("b"), nil, nil, 1),
+	}
+	heights := []uint64{100, 110, 120, 130, 140}
+	results := []*types.ElectionResult{}
+	for i, height := range heights {
+		calculator := types.NewResultCalculatorWithExclusion(
+			now.Add(time.Duration(i)*time.Hour),
+			false,
+			ec.voteFilter,
+			ec.calcWeightedVotes,
+			ec.candidateFilter,
+		)
+		require.NoError(calculator.AddCandidates(candidates))
+		for j := 0; j < 20; j++ {
+			v, err := types.NewVote(
+				now.Add(-time.Duration(100-j)*time.Hour),
+				time.Duration(j)*24*time.Hour,
+				big.NewInt(int64(1000+j+i)),
+				big.NewInt(0),
+				[]byte{byte(j)},
+				candidates[j%2].Name(),
+				false,
+			)
+			require.NoError(err)
+			require.NoError(calculator.AddVotes([]*types.Vote{v}))
+		}
+		result, err := calculator.Calculate()
+		require.NoError(err)
+		data, err := result.Serialize()
+		require.NoError(err)
+		require.NoError(raw.Put(ec.dbKey(height), data))
+		results = append(results, result)
+	}
+	require.NoError(raw.Put(db.NextHeightKey, util.Uint64ToBytes(150)))
+	require.NoError(raw.Put(db.LastUpdateTimeKey, util.Uint64ToBytes(uint64(now.Unix()))))
+
+	version, err := ec.schemaVersion()
+	require.NoError(err)
+	require.Equal(uint32(0), version)
+	// legacy records are readable through the envelope store
+	data, err := ec.db.Get(db.NextHeightKey)
+	require.NoError(err)
+	require.Equal(uint64(150), util.BytesToUint64(data))
+
+	require.NoError(ec.migrate())
+	version, err = ec.schemaVersion()
+	require.NoError(err)
+	require.Equal(SchemaVersion, version)
+	for _, key := range [][]byte{db.NextHeightKey, db.LastUpdateTimeKey, resultFormatKey, ec.dbKey(heights[2])} {
+		data, err := raw.Get(key)
+		require.NoError(err)
+		require.True(bytes.HasPrefix(data, envelopeMagic), "key %s", key)
+	}
+	for i, height := range heights {
+		result, err := ec.resultByHeight(height)
+		require.NoError(err)
+		expected, err := results[i].Serialize()
+		require.NoError(err)
+		actual, err := result.Serialize()
+		require.NoError(err)
+		require.Equal(expected, actual, "height %d", height)
+		_, err = ec.db.Get(ec.statisticsKey(height))
+		require.NoError(err, "height %d", height)
+	}
+	require.NoError(ec.load())
+	require.Equal(uint64(150), ec.nextHeight)
+	// migrating again is a no-op
+	require.NoError(ec.migrate())
+
+	t.Run("downgrade", func(t *testing.T) {
+		envelope, err := proto.Marshal(&pb.Envelope{Version: SchemaVersion + 1, Payload: []byte("future")})
+		require.NoError(err)
+		require.NoError(raw.Put([]byte("future"), append(append([]byte{}, envelopeMagic...), envelope...)))
+		_, err = ec.db.Get([]byte("future"))
+		require.Equal(ErrSchemaDowngrade, errors.Cause(err))
+
+		require.NoError(ec.db.Put(schemaVersionKey, util.Uint64ToBytes(uint64(SchemaVersion+1))))
+		require.Equal(ErrSchemaDowngrade, errors.Cause(ec.migrate()))
+		require.Equal(ErrSchemaDowngrade, errors.Cause(ec.load()))
+	})
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"bytes"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-election/db"
+	"github.com/iotexproject/iotex-election/types"
+)
+
+// BucketSelector selects the buckets of a voter, for a candidate if Candidate is not empty
+type BucketSelector struct {
+	Voter     []byte
+	Candidate []byte
+}
+
+func (s BucketSelector) match(v *types.Vote) bool {
+	return bytes.Equal(s.Voter, v.Voter()) &&
+		(len(s.Candidate) == 0 || bytes.Equal(s.Candidate, v.Candidate()))
+}
+
+// VoteMove moves the selected buckets to another candidate
+type VoteMove struct {
+	BucketSelector
+	To []byte
+}
+
+// DurationChange changes the stake duration of the selected buckets
+type DurationChange struct {
+	BucketSelector
+	Duration time.Duration
+}
+
+// Scenario defines the hypothetical changes applied to a stored result. The changes are applied in
+// the order of removing, moving, changing durations and adding buckets. Thresholds left nil are
+// kept as configured.
+type Scenario struct {
+	RemoveBuckets        []BucketSelector
+	MoveVotes            []VoteMove
+	ChangeDurations      []DurationChange
+	AddBuckets           []*types.Vote
+	VoteThreshold        *big.Int
+	ScoreThreshold       *big.Int
+	SelfStakingThreshold *big.Int
+}
+
+// DelegateChange defines the difference of a delegate between the real and the simulated results.
+// A rank is 1-based, and 0 means the candidate is not a delegate in the result.
+type DelegateChange struct {
+	Name     []byte
+	OldRank  int
+	NewRank  int
+	OldScore *big.Int
+	NewScore *big.Int
+}
+
+// Simulation defines the simulated result of a scenario and its difference from the real result
+type Simulation struct {
+	Result  *types.ElectionResult
+	Changes []DelegateChange
+}
+
+// ErrUnknownExclusions indicates that the candidates and votes excluded from the result are unknown,
+// without which the thresholds cannot be lowered in a simulation
+var ErrUnknownExclusions = errors.New("exclusions of the result are unknown to lower the thresholds")
+
+// Simulate applies the scenario to the result on a height and recalculates the ranking. The
+// candidates and votes excluded from the result are taken into account along with the delegates and
+// their votes, such that lowered thresholds could admit them. If the exclusions are not stored or
+// have been pruned, lowering a threshold is refused.
+func (ec *committee) Simulate(height uint64, scenario *Scenario) (*Simulation, error) {
+	result, err := ec.ResultByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	audit, err := ec.AuditByHeight(height)
+	switch errors.Cause(err) {
+	case nil:
+		if audit.Pruned() {
+			audit = nil
+		}
+	case db.ErrNotExist:
+		audit = nil
+	default:
+		return nil, err
+	}
+	if audit == nil && scenario.lowersThresholds(ec) {
+		return nil, ErrUnknownExclusions
+	}
+	candidates := append([]*types.Candidate{}, result.Delegates()...)
+	votes := []*types.Vote{}
+	for _, d := range result.Delegates() {
+		votes = append(votes, result.VotesByDelegate(d.Name())...)
+	}
+	if audit != nil {
+		for _, c := range audit.Candidates() {
+			candidates = append(candidates, c.Candidate)
+		}
+		for _, v := range audit.Votes() {
+			votes = append(votes, v.Vote)
+		}
+	}
+	names := map[string]bool{}
+	for _, c := range candidates {
+		names[string(c.Name())] = true
+	}
+	if votes, err = scenario.apply(votes, names); err != nil {
+		return nil, err
+	}
+	calculator := types.NewResultCalculatorWithExclusion(
+		result.MintTime(),
+		ec.skipManifiedCandidate,
+		func(v *types.Vote) bool {
+			return thresholdOrDefault(scenario.VoteThreshold, ec.voteThreshold).Cmp(v.Amount()) > 0
+		},
+		ec.calcWeightedVotes,
+		types.ThresholdFilter(
+			thresholdOrDefault(scenario.ScoreThreshold, ec.scoreThreshold),
+			thresholdOrDefault(scenario.SelfStakingThreshold, ec.selfStakingThreshold),
+		),
+	)
+	if err := calculator.AddCandidates(candidates); err != nil {
+		return nil, err
+	}
+	if err := calculator.AddVotes(votes); err != nil {
+		return nil, err
+	}
+	simulated, err := calculator.Calculate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Simulation{
+		Result:  simulated,
+		Changes: diffDelegates(result, simulated),
+	}, nil
+}
+
+// lowersThresholds returns true if any threshold of the scenario is lower than the configured one
+func (s *Scenario) lowersThresholds(ec *committee) bool {
+	return s.VoteThreshold != nil && s.VoteThreshold.Cmp(ec.voteThreshold) < 0 ||
+		s.ScoreThreshold != nil && s.ScoreThreshold.Cmp(ec.scoreThreshold) < 0 ||
+		s.SelfStakingThreshold != nil && s.SelfStakingThreshold.Cmp(ec.selfStakingThreshold) < 0
+}
+
+func (s *Scenario) apply(votes []*types.Vote, candidates map[string]bool) ([]*types.Vote, error) {
+	retval := []*types.Vote{}
+	for _, v := range votes {
+		removed := false
+		for _, selector := range s.RemoveBuckets {
+			if selector.match(v) {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			retval = append(retval, v)
+		}
+	}
+	for _, move := range s.MoveVotes {
+		if !candidates[string(move.To)] {
+			return nil, errors.Errorf("candidate %x is unknown", move.To)
+		}
+		for i, v := range retval {
+			if !move.match(v) {
+				continue
+			}
+			moved, err := types.NewVote(
+				v.StartTime(),
+				v.Duration(),
+				v.Amount(),
+				v.WeightedAmount(),
+				v.Voter(),
+				move.To,
+				v.Decay(),
+			)
+			if err != nil {
+				return nil, err
+			}
+			retval[i] = moved
+		}
+	}
+	for _, change := range s.ChangeDurations {
+		for i, v := range retval {
+			if !change.match(v) {
+				continue
+			}
+			changed, err := types.NewVote(
+				v.StartTime(),
+				change.Duration,
+				v.Amount(),
+				v.WeightedAmount(),
+				v.Voter(),
+				v.Candidate(),
+				v.Decay(),
+			)
+			if err != nil {
+				return nil, err
+			}
+			retval[i] = changed
+		}
+	}
+	for _, v := range s.AddBuckets {
+		if !candidates[string(v.Candidate())] {
+			return nil, errors.Errorf("candidate %x is unknown", v.Candidate())
+		}
+		retval = append(retval, v)
+	}
+
+	return retval, nil
+}
+
+func thresholdOrDefault(threshold *big.Int, defaultThreshold *big.Int) *big.Int {
+	if threshold != nil {
+		return threshold
+	}
+	return defaultThreshold
+}
+
+// diffDelegates returns the changes of delegates in the rank order of the simulated result,
+// followed by the ones dropped from the simulated result
+func diffDelegates(real *types.ElectionResult, simulated *types.ElectionResult) []DelegateChange {
+	changes := []DelegateChange{}
+	oldRanks := map[string]int{}
+	for i, d := range real.Delegates() {
+		oldRanks[string(d.Name())] = i + 1
+	}
+	newRanks := map[string]int{}
+	for i, d := range simulated.Delegates() {
+		newRanks[string(d.Name())] = i + 1
+		change := DelegateChange{
+			Name:     d.Name(),
+			NewRank:  i + 1,
+			OldScore: big.NewInt(0),
+			NewScore: d.Score(),
+		}
+		if rank, ok := oldRanks[string(d.Name())]; ok {
+			change.OldRank = rank
+			change.OldScore = real.Delegates()[rank-1].Score()
+		}
+		changes = append(changes, change)
+	}
+	for i, d := range real.Delegates() {
+		if _, ok := newRanks[string(d.Name())]; ok {
+			continue
+		}
+		changes = append(changes, DelegateChange{
+			Name:     d.Name(),
+			OldRank:  i + 1,
+			OldScore: d.Score(),
+			NewScore: big.NewInt(0),
+		})
+	}
+
+	return changes
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-election/db"
+	"github.com/iotexproject/iotex-election/types"
+)
+
+func TestSimulate(t *testing.T) {
+	require := require.New(t)
+	mintTime := time.Unix(1559220700, 0)
+	alice := []byte("alice0000000")
+	bob := []byte("bob000000000")
+	carol := []byte("carol0000000")
+	whale := []byte("whale")
+	kvstore := db.NewInMemKVStore()
+	require.NoError(kvstore.Start(context.Background()))
+	ec := &committee{
+		db:                   kvstore,
+		cache:                newResultCache(0, 0),
+		startHeight:          100,
+		nextHeight:           110,
+		interval:             10,
+		voteThreshold:        big.NewInt(0),
+		scoreThreshold:       big.NewInt(0),
+		selfStakingThreshold: big.NewInt(0),
+	}
+	vote := func(voter []byte, candidate []byte, amount int64) *types.Vote {
+		v, err := types.NewVote(mintTime, 0, big.NewInt(amount), big.NewInt(0), voter, candidate, false)
+		require.NoError(err)
+		return v
+	}
+	calculator := types.NewResultCalculatorWithExclusion(mintTime, false, ec.voteFilter, ec.calcWeightedVotes, ec.candidateFilter)
+	require.NoError(calculator.AddCandidates([]*types.Candidate{
+		types.NewCandidate(alice, []byte("a"), nil, nil, 1),
+		types.NewCandidate(bob, []byte("b"), nil, nil, 1),
+		types.NewCandidate(carol, []byte("c"), nil, nil, 1),
+	}))
+	require.NoError(calculator.AddVotes([]*types.Vote{
+		vote([]byte("v1"), alice, 300),
+		vote([]byte("v2"), bob, 200),
+		vote([]byte("v3"), carol, 100),
+		vote(whale, carol, 150),
+		vote(whale, bob, 50),
+	}))
+	result, err := calculator.Calculate()
+	require.NoError(err)
+	ec.cache.insert(100, result)
+
+	t.Run("move-votes", func(t *testing.T) {
+		simulation, err := ec.Simulate(100, &Scenario{
+			MoveVotes: []VoteMove{{BucketSelector: BucketSelector{Voter: whale}, To: carol}},
+		})
+		require.NoError(err)
+		delegates := simulation.Result.Delegates()
+		require.Equal(carol, delegates[0].Name())
+		require.Equal(0, big.NewInt(300).Cmp(delegates[0].Score()))
+		require.Equal(alice, delegates[1].Name())
+		require.Equal(bob, delegates[2].Name())
+		require.Equal(DelegateChange{
+			Name:     carol,
+			OldRank:  2,
+			NewRank:  1,
+			OldScore: big.NewInt(250),
+			NewScore: big.NewInt(300),
+		}, simulation.Changes[0])
+		// the stored result is untouched
+		require.Equal(0, big.NewInt(250).Cmp(result.Delegates()[1].Score()))
+	})
+	t.Run("remove-and-threshold", func(t *testing.T) {
+		simulation, err := ec.Simulate(100, &Scenario{
+			RemoveBuckets:  []BucketSelector{{Voter: whale, Candidate: carol}},
+			AddBuckets:     []*types.Vote{vote([]byte("v4"), bob, 10)},
+			ScoreThreshold: big.NewInt(200),
+		})
+		require.NoError(err)
+		delegates := simulation.Result.Delegates()
+		require.Equal(2, len(delegates))
+		require.Equal(alice, delegates[0].Name())
+		require.Equal(bob, delegates[1].Name())
+		require.Equal(0, big.NewInt(260).Cmp(delegates[1].Score()))
+		dropped := simulation.Changes[2]
+		require.Equal(carol, dropped.Name)
+		require.Equal(0, dropped.NewRank)
+	})
+	t.Run("unknown-candidate", func(t *testing.T) {
+		_, err := ec.Simulate(100, &Scenario{
+			MoveVotes: []VoteMove{{BucketSelector: BucketSelector{Voter: whale}, To: []byte("nobody")}},
+		})
+		require.Error(err)
+	})
+}
+
+func TestSimulateLoweredThresholds(t *testing.T) {
+	require := require.New(t)
+	mintTime := time.Unix(1559220700, 0)
+	alice := []byte("alice0000000")
+	bob := []byte("bob000000000")
+	carol := []byte("carol0000000")
+	kvstore := db.NewInMemKVStore()
+	require.NoError(kvstore.Start(context.Background()))
+	ec := &committee{
+		db:                   kvstore,
+		cache:                newResultCache(0, 0),
+		startHeight:          100,
+		nextHeight:           110,
+		interval:             10,
+		voteThreshold:        big.NewInt(100),
+		scoreThreshold:       big.NewInt(200),
+		selfStakingThreshold: big.NewInt(0),
+	}
+	vote := func(voter []byte, candidate []byte, amount int64) *types.Vote {
+		v, err := types.NewVote(mintTime, 0, big.NewInt(amount), big.NewInt(0), voter, candidate, false)
+		require.NoError(err)
+		return v
+	}
+	calculator := types.NewResultCalculatorWithExclusion(mintTime, false, ec.voteFilter, ec.calcWeightedVotes, ec.candidateFilter)
+	require.NoError(calculator.AddCandidates([]*types.Candidate{
+		types.NewCandidate(alice, []byte("a"), nil, nil, 1),
+		types.NewCandidate(bob, []byte("b"), nil, nil, 1),
+		types.NewCandidate(carol, []byte("c"), nil, nil, 1),
+	}))
+	require.NoError(calculator.AddVotes([]*types.Vote{
+		vote([]byte("v1"), alice, 300),
+		vote([]byte("v2"), bob, 150),
+		vote([]byte("v3"), carol, 250),
+		vote([]byte("v4"), carol, 40),
+	}))
+	result, err := calculator.Calculate()
+	require.NoError(err)
+	require.Equal(2, len(result.Delegates()))
+	ec.cache.insert(100, result)
+	lowered := &Scenario{VoteThreshold: big.NewInt(0), ScoreThreshold: big.NewInt(100)}
+
+	// the exclusions are unknown
+	_, err = ec.Simulate(100, lowered)
+	require.Equal(ErrUnknownExclusions, err)
+	simulation, err := ec.Simulate(100, &Scenario{ScoreThreshold: big.NewInt(260)})
+	require.NoError(err)
+	require.Equal(1, len(simulation.Result.Delegates()))
+
+	audit, err := calculator.Audit()
+	require.NoError(err)
+	data, err := audit.Serialize()
+	require.NoError(err)
+	require.NoError(kvstore.Put(ec.auditKey(100), data))
+	simulation, err = ec.Simulate(100, lowered)
+	require.NoError(err)
+	delegates := simulation.Result.Delegates()
+	require.Equal(3, len(delegates))
+	require.Equal(alice, delegates[0].Name())
+	require.Equal(carol, delegates[1].Name())
+	require.Equal(0, big.NewInt(290).Cmp(delegates[1].Score()))
+	require.Equal(bob, delegates[2].Name())
+	require.Equal(DelegateChange{
+		Name:     bob,
+		NewRank:  3,
+		OldScore: big.NewInt(0),
+		NewScore: big.NewInt(150),
+	}, simulation.Changes[2])
+	// the votes could be moved to an excluded candidate
+	simulation, err = ec.Simulate(100, &Scenario{
+		MoveVotes: []VoteMove{{BucketSelector: BucketSelector{Voter: []byte("v1")}, To: bob}},
+	})
+	require.NoError(err)
+	require.Equal(bob, simulation.Result.Delegates()[0].Name())
+	require.Equal(0, big.NewInt(450).Cmp(simulation.Result.Delegates()[0].Score()))
+
+	// the exclusions have been pruned
+	data, err = audit.Summary().Serialize()
+	require.NoError(err)
+	require.NoError(kvstore.Put(ec.auditKey(100), data))
+	_, err = ec.Simulate(100, lowered)
+	require.Equal(ErrUnknownExclusions, err)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"time"
+)
+
+// SyncStatus defines the detailed status of syncing results from gravity chain
+type SyncStatus struct {
+	Status STATUS
+	// TipHeight and TipTime are of the latest gravity chain block seen
+	TipHeight uint64
+	TipTime   time.Time
+	// LastSyncedHeight and LastSyncedTime are of the latest stored result
+	LastSyncedHeight uint64
+	LastSyncedTime   time.Time
+	// LagHeights is the number of gravity chain blocks the stored results are behind the tip
+	LagHeights uint64
+	// LagDuration is the duration the stored results are behind the tip
+	LagDuration time.Duration
+	// PendingHeights is the number of heights waiting to be synced
+	PendingHeights uint64
+	// Failures are the quarantined heights with the last error of each
+	Failures []ConsistencyFailure
+	// Endpoint is the gravity chain api in use
+	Endpoint string
+	// CatchUpStartHeight and CatchUpTargetHeight are the range of heights of the current or the
+	// last catch-up, and CatchUpProgress is the ratio of synced heights in the range
+	CatchUpStartHeight  uint64
+	CatchUpTargetHeight uint64
+	CatchUpProgress     float64
+}
+
+func (ec *committee) SyncStatus() SyncStatus {
+	status := ec.Status()
+	ec.mutex.RLock()
+	defer ec.mutex.RUnlock()
+	s := SyncStatus{
+		Status:              status,
+		TipHeight:           ec.currentHeight,
+		TipTime:             ec.tipTime,
+		Failures:            ec.quarantine.failures(),
+		CatchUpStartHeight:  ec.catchUpStartHeight,
+		CatchUpTargetHeight: ec.catchUpTargetHeight,
+	}
+	if ec.carrier != nil {
+		s.Endpoint = ec.carrier.Endpoint()
+	}
+	if l := len(ec.heightManager.heights); l > 0 {
+		s.LastSyncedHeight = ec.heightManager.heights[l-1]
+		s.LastSyncedTime = ec.heightManager.times[l-1]
+	}
+	if s.TipHeight > s.LastSyncedHeight {
+		s.LagHeights = s.TipHeight - s.LastSyncedHeight
+	}
+	if !s.LastSyncedTime.IsZero() && s.TipTime.After(s.LastSyncedTime) {
+		s.LagDuration = s.TipTime.Sub(s.LastSyncedTime)
+	}
+	if ec.currentHeight >= ec.nextHeight+12 {
+		s.PendingHeights = (ec.currentHeight-12-ec.nextHeight)/ec.interval + 1
+	}
+	switch {
+	case ec.catchUpTargetHeight == 0:
+		// no catch-up has been started
+	case ec.nextHeight > ec.catchUpTargetHeight:
+		s.CatchUpProgress = 1
+	default:
+		total := (ec.catchUpTargetHeight-ec.catchUpStartHeight)/ec.interval + 1
+		s.CatchUpProgress = float64((ec.nextHeight-ec.catchUpStartHeight)/ec.interval) / float64(total)
+	}
+
+	return s
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-election/carrier"
+)
+
+type endpointCarrier struct {
+	carrier.Carrier
+}
+
+func (*endpointCarrier) Endpoint() string { return "https://gravity.chain" }
+
+func TestSyncStatus(t *testing.T) {
+	require := require.New(t)
+	now := time.Now()
+	ec := &committee{
+		carrier:       &endpointCarrier{},
+		heightManager: newHeightManager(),
+		quarantine:    newQuarantine(0),
+		startHeight:   100,
+		nextHeight:    100,
+		interval:      10,
+	}
+	s := ec.SyncStatus()
+	require.Equal(STARTING, s.Status)
+	require.Equal(0.0, s.CatchUpProgress)
+	require.Equal("https://gravity.chain", s.Endpoint)
+
+	ec.currentHeight = 212
+	ec.tipTime = now
+	ec.catchUpStartHeight = 100
+	ec.catchUpTargetHeight = 200
+	require.NoError(ec.heightManager.add(100, now.Add(-time.Hour)))
+	require.NoError(ec.heightManager.add(110, now.Add(-50*time.Minute)))
+	ec.nextHeight = 120
+	ec.quarantine.record(120, errors.New("timeout"), now)
+	s = ec.SyncStatus()
+	require.Equal(STARTING, s.Status)
+	require.Equal(uint64(212), s.TipHeight)
+	require.Equal(uint64(110), s.LastSyncedHeight)
+	require.Equal(uint64(102), s.LagHeights)
+	require.Equal(50*time.Minute, s.LagDuration)
+	require.Equal(uint64(9), s.PendingHeights)
+	require.Equal(1, len(s.Failures))
+	require.Equal("timeout", s.Failures[0].Cause)
+	require.InDelta(2.0/11, s.CatchUpProgress, 1e-9)
+}
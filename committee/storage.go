@@ -0,0 +1,235 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"bytes"
+	"compress/flate"
+	"io/ioutil"
+	"math"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-election/db"
+	pb "github.com/iotexproject/iotex-election/pb/election"
+	"github.com/iotexproject/iotex-election/types"
+	"github.com/iotexproject/iotex-election/util"
+)
+
+// defaultKeyframeInterval is the number of heights between two keyframes if none is configured
+const defaultKeyframeInterval = 24
+
+// resultFormatKey is the key of the height below which the results have been migrated from the
+// legacy format, i.e., serialized ElectionResult, to StoredResult records. It is math.MaxUint64 if
+// the migration is complete, and missing if the migration has not started.
+var resultFormatKey = []byte("result-format")
+
+// resultMagic prefixes every StoredResult record, which can never be the first bytes of a legacy
+// record, i.e., a serialized ElectionResult, such that the format of a record is known from the record
+// itself rather than from the progress of the migration
+var resultMagic = []byte{0xff, 'S', 'R'}
+
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	r := flate.NewReader(bytes.NewReader(data))
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// isKeyframe returns true if the result of height should be stored in full
+func (ec *committee) isKeyframe(height uint64) bool {
+	if ec.interval == 0 || ec.keyframeInterval <= 1 || height < ec.startHeight+ec.interval {
+		return true
+	}
+	return ((height-ec.startHeight)/ec.interval)%ec.keyframeInterval == 0
+}
+
+// deltaBase returns the result of the previous height to encode the result of height against, or
+// nil if the result should be stored as a keyframe
+func (ec *committee) deltaBase(height uint64, result *types.ElectionResult) *types.ElectionResult {
+	if result.Pruned() || ec.isKeyframe(height) {
+		return nil
+	}
+	base, err := ec.resultByHeight(height - ec.interval)
+	if err != nil || base.Pruned() {
+		return nil
+	}
+	return base
+}
+
+// putResult stores the result of height as a delta against base, which is the result of the previous
+// height, or as a keyframe if base is nil or height is a keyframe
+func (ec *committee) putResult(height uint64, result *types.ElectionResult, base *types.ElectionResult) error {
+	record := &pb.StoredResult{}
+	var data []byte
+	var err error
+	if base != nil && !base.Pruned() && !result.Pruned() && !ec.isKeyframe(height) {
+		record.Delta = true
+		record.BaseHeight = height - ec.interval
+		data, err = result.SerializeDelta(base)
+	} else {
+		data, err = result.Serialize()
+	}
+	if err != nil {
+		return err
+	}
+	if record.Data, err = compress(data); err != nil {
+		return err
+	}
+	if data, err = proto.Marshal(record); err != nil {
+		return err
+	}
+	if err := ec.db.Put(ec.dbKey(height), append(append([]byte{}, resultMagic...), data...)); err != nil {
+		return errors.Wrapf(err, "failed to put election result into db")
+	}
+	return nil
+}
+
+func (ec *committee) readRecord(height uint64) (*pb.StoredResult, error) {
+	data, err := ec.db.Get(ec.dbKey(height))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, db.ErrNotExist
+	}
+	if !bytes.HasPrefix(data, resultMagic) {
+		return &pb.StoredResult{Data: data}, nil
+	}
+	record := &pb.StoredResult{}
+	if err := proto.Unmarshal(data[len(resultMagic):], record); err != nil {
+		return nil, err
+	}
+	if record.Data, err = decompress(record.Data); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// readResult reads the result of height from db, reconstructing it from the base if it is stored as
+// a delta
+func (ec *committee) readResult(height uint64) (*types.ElectionResult, error) {
+	record, err := ec.readRecord(height)
+	if err != nil {
+		return nil, err
+	}
+	result := &types.ElectionResult{}
+	if !record.Delta {
+		return result, result.Deserialize(record.Data)
+	}
+	base, err := ec.resultByHeight(record.BaseHeight)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read base %d of height %d", record.BaseHeight, height)
+	}
+	return result, result.DeserializeDelta(base, record.Data)
+}
+
+// rekeyDependent stores the result of the height after height as a keyframe if it is a delta
+// against the result of height, which is about to be pruned
+func (ec *committee) rekeyDependent(height uint64) error {
+	next := height + ec.interval
+	if next >= ec.nextHeight {
+		return nil
+	}
+	record, err := ec.readRecord(next)
+	if err != nil {
+		return err
+	}
+	if !record.Delta || record.BaseHeight != height {
+		return nil
+	}
+	result, err := ec.readResult(next)
+	if err != nil {
+		return err
+	}
+	return ec.putResult(next, result, nil)
+}
+
+// loadResultFormat loads the progress of the migration from the legacy format
+func (ec *committee) loadResultFormat() error {
+	data, err := ec.db.Get(resultFormatKey)
+	switch errors.Cause(err) {
+	case nil:
+		ec.migratedHeight = util.BytesToUint64(data)
+		ec.legacyFormat = ec.migratedHeight != math.MaxUint64
+	case db.ErrNotExist:
+		ec.legacyFormat = true
+		ec.migratedHeight = 0
+	default:
+		return err
+	}
+	return nil
+}
+
+// migrateResultFormat rewrites the results stored in the legacy format as keyframes and deltas. The
+// progress is persisted after each height, such that an interrupted migration resumes where it stopped.
+// A height rewritten before the progress is persisted is read in its new format and rewritten again.
+func (ec *committee) migrateResultFormat() error {
+	if err := ec.loadResultFormat(); err != nil {
+		return err
+	}
+	if !ec.legacyFormat {
+		return nil
+	}
+	nextHeight := ec.startHeight
+	if data, err := ec.db.Get(db.NextHeightKey); err == nil {
+		nextHeight = util.BytesToUint64(data)
+	}
+	height := ec.startHeight
+	if ec.migratedHeight > height {
+		height = ec.migratedHeight
+	}
+	var base *types.ElectionResult
+	if height > ec.startHeight && height < nextHeight {
+		var err error
+		if base, err = ec.readResult(height - ec.interval); err != nil {
+			return err
+		}
+	}
+	for ; height < nextHeight; height += ec.interval {
+		result, err := ec.readResult(height)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read legacy result of height %d", height)
+		}
+		if err := ec.putResult(height, result, base); err != nil {
+			return err
+		}
+		if err := ec.db.Put(resultFormatKey, util.Uint64ToBytes(height+ec.interval)); err != nil {
+			return err
+		}
+		ec.migratedHeight = height + ec.interval
+		base = result
+		zap.L().Debug("migrated result", zap.Uint64("height", height))
+	}
+	if err := ec.db.Put(resultFormatKey, util.Uint64ToBytes(math.MaxUint64)); err != nil {
+		return err
+	}
+	ec.legacyFormat = false
+	ec.migratedHeight = math.MaxUint64
+
+	return nil
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package committee
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-election/db"
+	"github.com/iotexproject/iotex-election/types"
+	"github.com/iotexproject/iotex-election/util"
+)
+
+func TestDeltaStorage(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "storage")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	newCommittee := func(name string) *committee {
+		store := db.NewKVStoreWithNamespaceWrapper(
+			Namespace,
+			db.NewBoltDB(db.Config{NumOfRetries: 3, DBPath: filepath.Join(dir, name)}),
+		)
+		require.NoError(store.Start(ctx))
+		return &committee{
+			db:                   store,
+			cache:                newResultCache(0, 0),
+			heightManager:        newHeightManager(),
+			quarantine:           newQuarantine(0),
+			startHeight:          100,
+			nextHeight:           100,
+			interval:             10,
+			keyframeInterval:     4,
+			voteThreshold:        big.NewInt(0),
+			scoreThreshold:       big.NewInt(0),
+			selfStakingThreshold: big.NewInt(0),
+		}
+	}
+	// results of 10 heights with 500 buckets, each of which differs from the previous by a bucket
+	now := time.Now()
+	candidates := []*types.Candidate{
+		types.NewCandidate([]byte("alice0000000"), []byte("a"), nil, nil, 1),
+		types.NewCandidate([]byte("bob000000000"), []byte("b"), nil, nil, 1),
+	}
+	votes := []*types.Vote{}
+	for i := 0; i < 510; i++ {
+		v, err := types.NewVote(
+			now.Add(-time.Duration(1000-i)*time.Hour),
+			time.Duration(i%30)*24*time.Hour,
+			big.NewInt(int64(1000+i)),
+			big.NewInt(0),
+			[]byte{byte(i >> 8), byte(i)},
+			candidates[i%2].Name(),
+			i%3 == 0,
+		)
+		require.NoError(err)
+		votes = append(votes, v)
+	}
+	heights := []uint64{}
+	results := []*types.ElectionResult{}
+	for i := 0; i < 10; i++ {
+		ec := &committee{voteThreshold: big.NewInt(0), scoreThreshold: big.NewInt(0), selfStakingThreshold: big.NewInt(0)}
+		mintTime := now.Add(time.Duration(i) * time.Hour)
+		calculator := types.NewResultCalculatorWithExclusion(mintTime, false, ec.voteFilter, ec.calcWeightedVotes, ec.candidateFilter)
+		require.NoError(calculator.AddCandidates(candidates))
+		require.NoError(calculator.AddVotes(votes[i : 500+i]))
+		result, err := calculator.Calculate()
+		require.NoError(err)
+		heights = append(heights, 100+uint64(i)*10)
+		results = append(results, result)
+	}
+	requireResults := func(ec *committee) {
+		// bypass the cache to reconstruct the results from db
+		ec.cache = newResultCache(0, 1)
+		for i, height := range heights {
+			result, err := ec.resultByHeight(height)
+			require.NoError(err)
+			expected, err := results[i].Serialize()
+			require.NoError(err)
+			actual, err := result.Serialize()
+			require.NoError(err)
+			require.Equal(expected, actual, "height %d", height)
+		}
+	}
+
+	ec := newCommittee("delta.db")
+	defer ec.db.Stop(ctx)
+	require.NoError(ec.migrateResultFormat())
+	for i, height := range heights {
+		require.NoError(ec.storeValidResult(height, results[i], nil))
+	}
+	for i, height := range heights {
+		record, err := ec.readRecord(height)
+		require.NoError(err)
+		require.Equal(i%4 != 0, record.Delta, "height %d", height)
+	}
+	requireResults(ec)
+
+	t.Run("size", func(t *testing.T) {
+		stored := 0
+		full := 0
+		for i, height := range heights {
+			data, err := ec.db.Get(ec.dbKey(height))
+			require.NoError(err)
+			stored += len(data)
+			data, err = results[i].Serialize()
+			require.NoError(err)
+			full += len(data)
+		}
+		require.True(stored*10 < full, "stored %d bytes, full results of %d bytes", stored, full)
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		require.NoError(ec.pruneResult(heights[1]))
+		record, err := ec.readRecord(heights[2])
+		require.NoError(err)
+		require.False(record.Delta)
+		result, err := ec.resultByHeight(heights[1])
+		require.NoError(err)
+		require.True(result.Pruned())
+		_, err = ec.ResultByHeight(heights[1])
+		require.Equal(ErrPruned, err)
+		summary, err := ec.SummaryByHeight(heights[1])
+		require.NoError(err)
+		require.Equal(result.Delegates(), summary.Delegates())
+		summary, err = ec.SummaryByHeight(heights[2])
+		require.NoError(err)
+		require.True(summary.Pruned())
+		require.Empty(summary.Votes())
+		results[1] = results[1].Summary()
+		requireResults(ec)
+	})
+
+	t.Run("migrate", func(t *testing.T) {
+		legacy := newCommittee("legacy.db")
+		defer legacy.db.Stop(ctx)
+		for i, height := range heights {
+			data, err := results[i].Serialize()
+			require.NoError(err)
+			require.NoError(legacy.db.Put(legacy.dbKey(height), data))
+		}
+		require.NoError(legacy.db.Put(db.NextHeightKey, legacy.dbKey(heights[len(heights)-1]+10)))
+		require.NoError(legacy.loadResultFormat())
+		require.True(legacy.legacyFormat)
+		requireResults(legacy)
+		// an interrupted migration which has rewritten the first three heights
+		for i, height := range heights[:3] {
+			var base *types.ElectionResult
+			if i > 0 {
+				base = results[i-1]
+			}
+			require.NoError(legacy.putResult(height, results[i], base))
+		}
+		require.NoError(legacy.db.Put(resultFormatKey, util.Uint64ToBytes(heights[3])))
+		// and crashed between rewriting the fourth height and persisting the progress
+		require.NoError(legacy.putResult(heights[3], results[3], results[2]))
+		require.NoError(legacy.loadResultFormat())
+		require.True(legacy.legacyFormat)
+		require.Equal(heights[3], legacy.migratedHeight)
+		record, err := legacy.readRecord(heights[3])
+		require.NoError(err)
+		require.True(record.Delta)
+		requireResults(legacy)
+		require.NoError(legacy.migrateResultFormat())
+		data, err := legacy.db.Get(resultFormatKey)
+		require.NoError(err)
+		require.Equal(uint64(math.MaxUint64), util.BytesToUint64(data))
+		require.False(legacy.legacyFormat)
+		requireResults(legacy)
+		record, err = legacy.readRecord(heights[5])
+		require.NoError(err)
+		require.True(record.Delta)
+		// migrating again is a no-op
+		require.NoError(legacy.migrateResultFormat())
+		requireResults(legacy)
+	})
+}
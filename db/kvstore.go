@@ -12,13 +12,21 @@ package db
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.etcd.io/bbolt"
+
+	"github.com/iotexproject/iotex-election/util"
 )
 
 const (
 	filemode = 0600
+	// openTimeout is the time to wait for the file lock when opening a read-only boltDB
+	openTimeout = 10 * time.Second
 )
 
 var (
@@ -28,12 +36,20 @@ var (
 	ErrNotExist = errors.New("key does not exist")
 	// NextHeightKey defines the constant key of next height
 	NextHeightKey = []byte("next-height")
+	// PrunedHeightKey defines the constant key of the height below which results have been pruned
+	PrunedHeightKey = []byte("pruned-height")
+	// LastUpdateTimeKey defines the constant key of the tip time of the last sync
+	LastUpdateTimeKey = []byte("last-update-time")
+	// ErrReadOnly indicates that the db is opened in read-only mode
+	ErrReadOnly = errors.New("DB is read-only")
 )
 
 // Config defines the config of db
 type Config struct {
 	NumOfRetries uint8  `yaml:"numOfRetries"`
 	DBPath       string `yaml:"dbPath"`
+	// ReadOnly opens the db in read-only mode, e.g., a snapshot produced by another process
+	ReadOnly bool `yaml:"readOnly"`
 }
 
 // KVStore defines the db interface using in committee
@@ -80,6 +96,27 @@ func (m *memStore) Put(key []byte, value []byte) error {
 	return nil
 }
 
+// Reloader defines the interface of a kv store which could reload the data changed by another process
+type Reloader interface {
+	// Reload reopens the kv store if it has been replaced since last load
+	Reload(context.Context) error
+	// Stamp returns the time the kv store was last stamped as up to date by its writer
+	Stamp() (time.Time, error)
+}
+
+// Snapshotter defines the interface of a kv store which could write a consistent copy of itself
+type Snapshotter interface {
+	// Snapshot writes a copy of the kv store to path
+	Snapshot(path string) error
+	// Touch stamps the copy at path as up to date at the given time without copying it again
+	Touch(path string, ts time.Time) error
+}
+
+// stampPath returns the path of the stamp file written next to a snapshot
+func stampPath(path string) string {
+	return path + ".stamp"
+}
+
 // KVStoreWithNamespace defines the db interface with namesapce
 type KVStoreWithNamespace interface {
 	Start(context.Context) error
@@ -122,10 +159,46 @@ func (w *KVStoreWithNamespaceWrapper) Put(key []byte, value []byte) error {
 	return w.store.Put(w.namespace, key, value)
 }
 
+// Reload reloads the kv store if it supports
+func (w *KVStoreWithNamespaceWrapper) Reload(ctx context.Context) error {
+	if r, ok := w.store.(Reloader); ok {
+		return r.Reload(ctx)
+	}
+	return nil
+}
+
+// Stamp returns the time the kv store was last stamped by its writer if it supports
+func (w *KVStoreWithNamespaceWrapper) Stamp() (time.Time, error) {
+	if r, ok := w.store.(Reloader); ok {
+		return r.Stamp()
+	}
+	return time.Time{}, ErrNotExist
+}
+
+// Snapshot writes a copy of the kv store to path
+func (w *KVStoreWithNamespaceWrapper) Snapshot(path string) error {
+	if s, ok := w.store.(Snapshotter); ok {
+		return s.Snapshot(path)
+	}
+	return errors.New("snapshot is not supported")
+}
+
+// Touch stamps the copy of the kv store at path as up to date
+func (w *KVStoreWithNamespaceWrapper) Touch(path string, ts time.Time) error {
+	if s, ok := w.store.(Snapshotter); ok {
+		return s.Touch(path, ts)
+	}
+	return errors.New("snapshot is not supported")
+}
+
 type boltDB struct {
 	db         *bbolt.DB
 	path       string
 	numRetries uint8
+	readOnly   bool
+	// modTime is the modification time of the file when a read-only boltDB was opened
+	modTime time.Time
+	lock    sync.RWMutex
 }
 
 // NewBoltDB creates a new boltDB
@@ -133,12 +206,32 @@ func NewBoltDB(cfg Config) KVStoreWithNamespace {
 	return &boltDB{
 		numRetries: cfg.NumOfRetries,
 		path:       cfg.DBPath,
+		readOnly:   cfg.ReadOnly,
 	}
 }
 
 // Start starts the boltDB
 func (b *boltDB) Start(_ context.Context) error {
-	db, err := bbolt.Open(b.path, filemode, nil)
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.open()
+}
+
+func (b *boltDB) open() error {
+	var options *bbolt.Options
+	if b.readOnly {
+		options = &bbolt.Options{ReadOnly: true, Timeout: openTimeout}
+		// the time is taken before opening, such that a replacement during opening is reloaded later
+		info, err := os.Stat(b.path)
+		if err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+		b.modTime = info.ModTime()
+	}
+	db, err := bbolt.Open(b.path, filemode, options)
+	if err == bbolt.ErrTimeout {
+		return errors.Wrapf(ErrIO, "%s is locked by its writer, open a snapshot of it instead", b.path)
+	}
 	if err != nil {
 		return errors.Wrapf(ErrIO, err.Error())
 	}
@@ -146,38 +239,120 @@ func (b *boltDB) Start(_ context.Context) error {
 	return nil
 }
 
+// Reload reopens a read-only boltDB to pick up the changes made by the writer, if the file has been
+// replaced since last open
+func (b *boltDB) Reload(ctx context.Context) error {
+	if !b.readOnly {
+		return nil
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	info, err := os.Stat(b.path)
+	if err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	if info.ModTime().Equal(b.modTime) {
+		return nil
+	}
+	if err := b.close(); err != nil {
+		return err
+	}
+	return b.open()
+}
+
+// Stamp returns the time the writer last stamped the boltDB as up to date
+func (b *boltDB) Stamp() (time.Time, error) {
+	data, err := ioutil.ReadFile(stampPath(b.path))
+	switch {
+	case os.IsNotExist(err):
+		return time.Time{}, errors.Wrapf(ErrNotExist, "stamp of %s", b.path)
+	case err != nil:
+		return time.Time{}, errors.Wrap(ErrIO, err.Error())
+	case len(data) != 8:
+		return time.Time{}, errors.Wrapf(ErrIO, "invalid stamp of %s", b.path)
+	}
+	return time.Unix(int64(util.BytesToUint64(data)), 0), nil
+}
+
+// Snapshot writes a consistent copy of the boltDB to path, which is replaced atomically
+func (b *boltDB) Snapshot(path string) error {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	tmpPath := path + ".tmp"
+	if err := b.db.View(func(tx *bbolt.Tx) error {
+		return tx.CopyFile(tmpPath, filemode)
+	}); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
+// Touch writes the stamp of the copy at path, which is replaced atomically
+func (b *boltDB) Touch(path string, ts time.Time) error {
+	tmpPath := stampPath(path) + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, util.Uint64ToBytes(uint64(ts.Unix())), filemode); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	if err := os.Rename(tmpPath, stampPath(path)); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
 // Stop stops the boltDB
 func (b *boltDB) Stop(_ context.Context) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.close()
+}
+
+func (b *boltDB) close() error {
 	if b.db != nil {
 		if err := b.db.Close(); err != nil {
 			return errors.Wrap(ErrIO, err.Error())
 		}
+		b.db = nil
 	}
 	return nil
 }
 
 // Get gets value by key from boltDB
 func (b *boltDB) Get(namespace string, key []byte) ([]byte, error) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
 	var value []byte
 	err := b.db.View(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(namespace))
 		if bucket == nil {
-			return errors.Wrapf(bbolt.ErrBucketNotFound, "bucket = %s", namespace)
+			// nothing has been put into the namespace yet
+			return nil
+		}
+		// copy the value since it is only valid during the transaction, and the memory map will be
+		// released once the db is reloaded
+		if v := bucket.Get(key); v != nil {
+			value = append([]byte{}, v...)
 		}
-		value = bucket.Get(key)
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 	if value == nil {
-		err = errors.Wrapf(ErrNotExist, "key = %s", string(key))
+		return nil, errors.Wrapf(ErrNotExist, "key = %s", string(key))
 	}
 	return value, nil
 }
 
 // Put stores key and value to boltDB
 func (b *boltDB) Put(namespace string, key []byte, value []byte) error {
+	if b.readOnly {
+		return ErrReadOnly
+	}
+	b.lock.RLock()
+	defer b.lock.RUnlock()
 	var err error
 	for c := uint8(0); c < b.numRetries; c++ {
 		err = b.db.Update(func(tx *bbolt.Tx) error {
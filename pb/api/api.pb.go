@@ -7,7 +7,10 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	math "math"
 )
@@ -23,6 +26,90 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type BucketSortKey int32
+
+const (
+	// the canonical order of the result
+	BucketSortKey_CANONICAL          BucketSortKey = 0
+	BucketSortKey_AMOUNT             BucketSortKey = 1
+	BucketSortKey_WEIGHTED_AMOUNT    BucketSortKey = 2
+	BucketSortKey_REMAINING_DURATION BucketSortKey = 3
+	BucketSortKey_START_TIME         BucketSortKey = 4
+	BucketSortKey_VOTER              BucketSortKey = 5
+)
+
+var BucketSortKey_name = map[int32]string{
+	0: "CANONICAL",
+	1: "AMOUNT",
+	2: "WEIGHTED_AMOUNT",
+	3: "REMAINING_DURATION",
+	4: "START_TIME",
+	5: "VOTER",
+}
+
+var BucketSortKey_value = map[string]int32{
+	"CANONICAL":          0,
+	"AMOUNT":             1,
+	"WEIGHTED_AMOUNT":    2,
+	"REMAINING_DURATION": 3,
+	"START_TIME":         4,
+	"VOTER":              5,
+}
+
+func (x BucketSortKey) String() string {
+	return proto.EnumName(BucketSortKey_name, int32(x))
+}
+
+func (BucketSortKey) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+
+type ExclusionReason int32
+
+const (
+	ExclusionReason_UNKNOWN_REASON             ExclusionReason = 0
+	ExclusionReason_MANIFIED_CANDIDATE         ExclusionReason = 1
+	ExclusionReason_FILTERED_CANDIDATE         ExclusionReason = 2
+	ExclusionReason_FILTERED_VOTE              ExclusionReason = 3
+	ExclusionReason_ZERO_CANDIDATE_VOTE        ExclusionReason = 4
+	ExclusionReason_UNKNOWN_CANDIDATE_VOTE     ExclusionReason = 5
+	ExclusionReason_EXCLUDED_CANDIDATE_VOTE    ExclusionReason = 6
+	ExclusionReason_LOW_SCORE_CANDIDATE        ExclusionReason = 7
+	ExclusionReason_LOW_SELF_STAKING_CANDIDATE ExclusionReason = 8
+)
+
+var ExclusionReason_name = map[int32]string{
+	0: "UNKNOWN_REASON",
+	1: "MANIFIED_CANDIDATE",
+	2: "FILTERED_CANDIDATE",
+	3: "FILTERED_VOTE",
+	4: "ZERO_CANDIDATE_VOTE",
+	5: "UNKNOWN_CANDIDATE_VOTE",
+	6: "EXCLUDED_CANDIDATE_VOTE",
+	7: "LOW_SCORE_CANDIDATE",
+	8: "LOW_SELF_STAKING_CANDIDATE",
+}
+
+var ExclusionReason_value = map[string]int32{
+	"UNKNOWN_REASON":             0,
+	"MANIFIED_CANDIDATE":         1,
+	"FILTERED_CANDIDATE":         2,
+	"FILTERED_VOTE":              3,
+	"ZERO_CANDIDATE_VOTE":        4,
+	"UNKNOWN_CANDIDATE_VOTE":     5,
+	"EXCLUDED_CANDIDATE_VOTE":    6,
+	"LOW_SCORE_CANDIDATE":        7,
+	"LOW_SELF_STAKING_CANDIDATE": 8,
+}
+
+func (x ExclusionReason) String() string {
+	return proto.EnumName(ExclusionReason_name, int32(x))
+}
+
+func (ExclusionReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+
 type HealthCheckResponse_Status int32
 
 const (
@@ -48,7 +135,7 @@ func (x HealthCheckResponse_Status) String() string {
 }
 
 func (HealthCheckResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{7, 0}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{14, 0}
 }
 
 type ChainMeta struct {
@@ -120,7 +207,9 @@ type Bucket struct {
 	Votes         string `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
 	WeightedVotes string `protobuf:"bytes,3,opt,name=weightedVotes,proto3" json:"weightedVotes,omitempty"`
 	// human readable duration
-	RemainingDuration    string   `protobuf:"bytes,4,opt,name=remainingDuration,proto3" json:"remainingDuration,omitempty"`
+	RemainingDuration string `protobuf:"bytes,4,opt,name=remainingDuration,proto3" json:"remainingDuration,omitempty"`
+	// io1 address of the voter
+	VoterIoAddress       string   `protobuf:"bytes,5,opt,name=voterIoAddress,proto3" json:"voterIoAddress,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -179,14 +268,29 @@ func (m *Bucket) GetRemainingDuration() string {
 	return ""
 }
 
+func (m *Bucket) GetVoterIoAddress() string {
+	if m != nil {
+		return m.VoterIoAddress
+	}
+	return ""
+}
+
 type Candidate struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// hex string
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	TotalWeightedVotes   string   `protobuf:"bytes,3,opt,name=totalWeightedVotes,proto3" json:"totalWeightedVotes,omitempty"`
-	SelfStakingTokens    string   `protobuf:"bytes,4,opt,name=selfStakingTokens,proto3" json:"selfStakingTokens,omitempty"`
-	OperatorAddress      string   `protobuf:"bytes,5,opt,name=operatorAddress,proto3" json:"operatorAddress,omitempty"`
-	RewardAddress        string   `protobuf:"bytes,6,opt,name=rewardAddress,proto3" json:"rewardAddress,omitempty"`
+	Address            string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	TotalWeightedVotes string `protobuf:"bytes,3,opt,name=totalWeightedVotes,proto3" json:"totalWeightedVotes,omitempty"`
+	SelfStakingTokens  string `protobuf:"bytes,4,opt,name=selfStakingTokens,proto3" json:"selfStakingTokens,omitempty"`
+	// io1 address, empty if unregistered or malformed
+	OperatorAddress string `protobuf:"bytes,5,opt,name=operatorAddress,proto3" json:"operatorAddress,omitempty"`
+	// io1 address, empty if unregistered or malformed
+	RewardAddress string `protobuf:"bytes,6,opt,name=rewardAddress,proto3" json:"rewardAddress,omitempty"`
+	// io1 address of the candidate on gravity chain
+	IoAddress string `protobuf:"bytes,7,opt,name=ioAddress,proto3" json:"ioAddress,omitempty"`
+	// true if the registered operator or reward address is malformed
+	MalformedAddress bool `protobuf:"varint,8,opt,name=malformedAddress,proto3" json:"malformedAddress,omitempty"`
+	// the resolved height if the candidate is the response of getCandidateByName, empty otherwise
+	Height               string   `protobuf:"bytes,9,opt,name=height,proto3" json:"height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -259,6 +363,27 @@ func (m *Candidate) GetRewardAddress() string {
 	return ""
 }
 
+func (m *Candidate) GetIoAddress() string {
+	if m != nil {
+		return m.IoAddress
+	}
+	return ""
+}
+
+func (m *Candidate) GetMalformedAddress() bool {
+	if m != nil {
+		return m.MalformedAddress
+	}
+	return false
+}
+
+func (m *Candidate) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
 type GetCandidatesRequest struct {
 	Height               string   `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
 	Offset               uint32   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
@@ -362,10 +487,14 @@ func (m *GetCandidateByNameRequest) GetHeight() string {
 }
 
 type GetBucketsByCandidateRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Height               string   `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
-	Offset               uint32   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit                uint32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Name       string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Height     string        `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	Offset     uint32        `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit      uint32        `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	SortBy     BucketSortKey `protobuf:"varint,5,opt,name=sortBy,proto3,enum=api.BucketSortKey" json:"sortBy,omitempty"`
+	Descending bool          `protobuf:"varint,6,opt,name=descending,proto3" json:"descending,omitempty"`
+	// the nextCursor of the previous page, which takes precedence over offset
+	Cursor               string   `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -424,10 +553,35 @@ func (m *GetBucketsByCandidateRequest) GetLimit() uint32 {
 	return 0
 }
 
+func (m *GetBucketsByCandidateRequest) GetSortBy() BucketSortKey {
+	if m != nil {
+		return m.SortBy
+	}
+	return BucketSortKey_CANONICAL
+}
+
+func (m *GetBucketsByCandidateRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+func (m *GetBucketsByCandidateRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 type GetBucketsRequest struct {
-	Height               string   `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
-	Offset               uint32   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit                uint32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Height     string        `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	Offset     uint32        `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit      uint32        `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	SortBy     BucketSortKey `protobuf:"varint,4,opt,name=sortBy,proto3,enum=api.BucketSortKey" json:"sortBy,omitempty"`
+	Descending bool          `protobuf:"varint,5,opt,name=descending,proto3" json:"descending,omitempty"`
+	// the nextCursor of the previous page, which takes precedence over offset
+	Cursor               string   `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -479,267 +633,3338 @@ func (m *GetBucketsRequest) GetLimit() uint32 {
 	return 0
 }
 
-type HealthCheckResponse struct {
-	Status               HealthCheckResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=api.HealthCheckResponse_Status" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+func (m *GetBucketsRequest) GetSortBy() BucketSortKey {
+	if m != nil {
+		return m.SortBy
+	}
+	return BucketSortKey_CANONICAL
 }
 
-func (m *HealthCheckResponse) Reset()         { *m = HealthCheckResponse{} }
-func (m *HealthCheckResponse) String() string { return proto.CompactTextString(m) }
-func (*HealthCheckResponse) ProtoMessage()    {}
-func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+func (m *GetBucketsRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+func (m *GetBucketsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type GetVoterRequest struct {
+	Height string `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	// hex or io1 string
+	Voter                string   `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVoterRequest) Reset()         { *m = GetVoterRequest{} }
+func (m *GetVoterRequest) String() string { return proto.CompactTextString(m) }
+func (*GetVoterRequest) ProtoMessage()    {}
+func (*GetVoterRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
 }
 
-func (m *HealthCheckResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HealthCheckResponse.Unmarshal(m, b)
+func (m *GetVoterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVoterRequest.Unmarshal(m, b)
 }
-func (m *HealthCheckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HealthCheckResponse.Marshal(b, m, deterministic)
+func (m *GetVoterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVoterRequest.Marshal(b, m, deterministic)
 }
-func (m *HealthCheckResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HealthCheckResponse.Merge(m, src)
+func (m *GetVoterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVoterRequest.Merge(m, src)
 }
-func (m *HealthCheckResponse) XXX_Size() int {
-	return xxx_messageInfo_HealthCheckResponse.Size(m)
+func (m *GetVoterRequest) XXX_Size() int {
+	return xxx_messageInfo_GetVoterRequest.Size(m)
 }
-func (m *HealthCheckResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_HealthCheckResponse.DiscardUnknown(m)
+func (m *GetVoterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVoterRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HealthCheckResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetVoterRequest proto.InternalMessageInfo
 
-func (m *HealthCheckResponse) GetStatus() HealthCheckResponse_Status {
+func (m *GetVoterRequest) GetHeight() string {
 	if m != nil {
-		return m.Status
+		return m.Height
 	}
-	return HealthCheckResponse_STARTING
+	return ""
 }
 
-type CandidateResponse struct {
-	Candidates           []*Candidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *GetVoterRequest) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
 }
 
-func (m *CandidateResponse) Reset()         { *m = CandidateResponse{} }
-func (m *CandidateResponse) String() string { return proto.CompactTextString(m) }
-func (*CandidateResponse) ProtoMessage()    {}
-func (*CandidateResponse) Descriptor() ([]byte, []int) {
+type VotedDelegate struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Votes                string   `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	WeightedVotes        string   `protobuf:"bytes,3,opt,name=weightedVotes,proto3" json:"weightedVotes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VotedDelegate) Reset()         { *m = VotedDelegate{} }
+func (m *VotedDelegate) String() string { return proto.CompactTextString(m) }
+func (*VotedDelegate) ProtoMessage()    {}
+func (*VotedDelegate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
 }
 
-func (m *CandidateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CandidateResponse.Unmarshal(m, b)
+func (m *VotedDelegate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VotedDelegate.Unmarshal(m, b)
 }
-func (m *CandidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CandidateResponse.Marshal(b, m, deterministic)
+func (m *VotedDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VotedDelegate.Marshal(b, m, deterministic)
 }
-func (m *CandidateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CandidateResponse.Merge(m, src)
+func (m *VotedDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotedDelegate.Merge(m, src)
 }
-func (m *CandidateResponse) XXX_Size() int {
-	return xxx_messageInfo_CandidateResponse.Size(m)
+func (m *VotedDelegate) XXX_Size() int {
+	return xxx_messageInfo_VotedDelegate.Size(m)
 }
-func (m *CandidateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CandidateResponse.DiscardUnknown(m)
+func (m *VotedDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotedDelegate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CandidateResponse proto.InternalMessageInfo
+var xxx_messageInfo_VotedDelegate proto.InternalMessageInfo
 
-func (m *CandidateResponse) GetCandidates() []*Candidate {
+func (m *VotedDelegate) GetName() string {
 	if m != nil {
-		return m.Candidates
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type BucketResponse struct {
-	Buckets              []*Bucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *VotedDelegate) GetVotes() string {
+	if m != nil {
+		return m.Votes
+	}
+	return ""
 }
 
-func (m *BucketResponse) Reset()         { *m = BucketResponse{} }
-func (m *BucketResponse) String() string { return proto.CompactTextString(m) }
-func (*BucketResponse) ProtoMessage()    {}
-func (*BucketResponse) Descriptor() ([]byte, []int) {
+func (m *VotedDelegate) GetWeightedVotes() string {
+	if m != nil {
+		return m.WeightedVotes
+	}
+	return ""
+}
+
+type VoterResponse struct {
+	// hex string
+	Voter              string    `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	Buckets            []*Bucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	TotalVotes         string    `protobuf:"bytes,3,opt,name=totalVotes,proto3" json:"totalVotes,omitempty"`
+	TotalWeightedVotes string    `protobuf:"bytes,4,opt,name=totalWeightedVotes,proto3" json:"totalWeightedVotes,omitempty"`
+	// in the order of the ranks of the delegates
+	Delegates []*VotedDelegate `protobuf:"bytes,5,rep,name=delegates,proto3" json:"delegates,omitempty"`
+	// io1 address of the voter
+	VoterIoAddress string `protobuf:"bytes,6,opt,name=voterIoAddress,proto3" json:"voterIoAddress,omitempty"`
+	// the resolved height
+	Height               string   `protobuf:"bytes,7,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoterResponse) Reset()         { *m = VoterResponse{} }
+func (m *VoterResponse) String() string { return proto.CompactTextString(m) }
+func (*VoterResponse) ProtoMessage()    {}
+func (*VoterResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
 }
 
-func (m *BucketResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BucketResponse.Unmarshal(m, b)
+func (m *VoterResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoterResponse.Unmarshal(m, b)
 }
-func (m *BucketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BucketResponse.Marshal(b, m, deterministic)
+func (m *VoterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoterResponse.Marshal(b, m, deterministic)
 }
-func (m *BucketResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BucketResponse.Merge(m, src)
+func (m *VoterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoterResponse.Merge(m, src)
 }
-func (m *BucketResponse) XXX_Size() int {
-	return xxx_messageInfo_BucketResponse.Size(m)
+func (m *VoterResponse) XXX_Size() int {
+	return xxx_messageInfo_VoterResponse.Size(m)
 }
-func (m *BucketResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BucketResponse.DiscardUnknown(m)
+func (m *VoterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoterResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BucketResponse proto.InternalMessageInfo
+var xxx_messageInfo_VoterResponse proto.InternalMessageInfo
 
-func (m *BucketResponse) GetBuckets() []*Bucket {
+func (m *VoterResponse) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *VoterResponse) GetBuckets() []*Bucket {
 	if m != nil {
 		return m.Buckets
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("api.HealthCheckResponse_Status", HealthCheckResponse_Status_name, HealthCheckResponse_Status_value)
-	proto.RegisterType((*ChainMeta)(nil), "api.ChainMeta")
-	proto.RegisterType((*Bucket)(nil), "api.Bucket")
-	proto.RegisterType((*Candidate)(nil), "api.Candidate")
-	proto.RegisterType((*GetCandidatesRequest)(nil), "api.GetCandidatesRequest")
-	proto.RegisterType((*GetCandidateByNameRequest)(nil), "api.GetCandidateByNameRequest")
-	proto.RegisterType((*GetBucketsByCandidateRequest)(nil), "api.GetBucketsByCandidateRequest")
-	proto.RegisterType((*GetBucketsRequest)(nil), "api.GetBucketsRequest")
-	proto.RegisterType((*HealthCheckResponse)(nil), "api.HealthCheckResponse")
-	proto.RegisterType((*CandidateResponse)(nil), "api.CandidateResponse")
-	proto.RegisterType((*BucketResponse)(nil), "api.BucketResponse")
+func (m *VoterResponse) GetTotalVotes() string {
+	if m != nil {
+		return m.TotalVotes
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
+func (m *VoterResponse) GetTotalWeightedVotes() string {
+	if m != nil {
+		return m.TotalWeightedVotes
+	}
+	return ""
+}
 
-var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x6f, 0xd3, 0x4c,
-	0x10, 0x8d, 0x9b, 0xd4, 0x6d, 0xa7, 0x5f, 0xfa, 0xb5, 0xdb, 0x12, 0xa5, 0x01, 0x95, 0x62, 0x81,
-	0x14, 0x21, 0xe4, 0xa2, 0xf6, 0xd0, 0x03, 0x12, 0x52, 0x9a, 0x96, 0x90, 0x03, 0x15, 0x38, 0x51,
-	0x11, 0x12, 0x97, 0x6d, 0x3c, 0x71, 0x56, 0x49, 0xbc, 0xc1, 0xbb, 0x69, 0xe9, 0x8d, 0x23, 0x7f,
-	0x81, 0x13, 0x3f, 0x8f, 0xbf, 0x81, 0xbc, 0xbb, 0xae, 0x9d, 0xc4, 0x11, 0x12, 0xe2, 0x96, 0x79,
-	0xfb, 0xfc, 0xe6, 0xcd, 0xdb, 0xc9, 0xc2, 0x06, 0x9d, 0x30, 0x77, 0x12, 0x71, 0xc9, 0x49, 0x91,
-	0x4e, 0x58, 0xed, 0x61, 0xc0, 0x79, 0x30, 0xc2, 0x23, 0x05, 0x5d, 0x4f, 0xfb, 0x47, 0x38, 0x9e,
-	0xc8, 0x3b, 0xcd, 0x70, 0x7e, 0x58, 0xb0, 0xd1, 0x1c, 0x50, 0x16, 0xbe, 0x43, 0x49, 0x49, 0x05,
-	0xec, 0x01, 0xb2, 0x60, 0x20, 0xab, 0xd6, 0xa1, 0x55, 0xdf, 0xf0, 0x4c, 0x45, 0xea, 0xf0, 0xbf,
-	0xe4, 0x92, 0x8e, 0x9a, 0x34, 0xf4, 0x99, 0x4f, 0x25, 0x8a, 0xea, 0xca, 0xa1, 0x55, 0x2f, 0x79,
-	0xf3, 0x30, 0x79, 0x0e, 0xdb, 0x0a, 0xba, 0xe2, 0x12, 0xfd, 0x8e, 0xa4, 0x43, 0x14, 0xd5, 0xa2,
-	0xd2, 0x5a, 0xc0, 0xc9, 0x01, 0xc0, 0x3d, 0x26, 0xaa, 0x25, 0xc5, 0xca, 0x20, 0xce, 0x77, 0x0b,
-	0xec, 0xb3, 0x69, 0x6f, 0x88, 0x92, 0xec, 0xc1, 0xea, 0x0d, 0x97, 0x18, 0x19, 0x5f, 0xba, 0x48,
-	0x50, 0x6d, 0xc6, 0xa0, 0x82, 0x3c, 0x85, 0xf2, 0xad, 0xb2, 0x8d, 0xbe, 0x56, 0xd6, 0xfd, 0x67,
-	0x41, 0xf2, 0x02, 0x76, 0x22, 0x1c, 0x53, 0x16, 0xb2, 0x30, 0x38, 0x9f, 0x46, 0x54, 0x32, 0x1e,
-	0x1a, 0x0f, 0x8b, 0x07, 0xce, 0xaf, 0x38, 0xa6, 0x64, 0x4a, 0x42, 0xa0, 0x14, 0xd2, 0x31, 0x1a,
-	0x33, 0xea, 0x37, 0xa9, 0xc2, 0x1a, 0xf5, 0xfd, 0x08, 0x45, 0xe2, 0x26, 0x29, 0x89, 0x0b, 0x44,
-	0x0d, 0xf5, 0x31, 0xc7, 0x54, 0xce, 0x49, 0xec, 0x4c, 0xe0, 0xa8, 0x1f, 0x87, 0xc4, 0xc2, 0xa0,
-	0xcb, 0x87, 0x18, 0x26, 0xe9, 0x2c, 0x1e, 0xc4, 0x57, 0xc3, 0x27, 0x18, 0x51, 0xc9, 0xa3, 0x86,
-	0xe9, 0xbf, 0xaa, 0xb8, 0xf3, 0x70, 0x9c, 0x4b, 0x84, 0xb7, 0x34, 0xf2, 0x13, 0x9e, 0xad, 0x73,
-	0x99, 0x01, 0x9d, 0xcf, 0xb0, 0xd7, 0x42, 0x99, 0xde, 0xa8, 0x87, 0x5f, 0xa6, 0x28, 0xe4, 0xd2,
-	0xd5, 0xa8, 0x80, 0xcd, 0xfb, 0x7d, 0x81, 0x52, 0x8d, 0x5d, 0xf6, 0x4c, 0x15, 0xdf, 0xcd, 0x88,
-	0x8d, 0x99, 0x54, 0x83, 0x96, 0x3d, 0x5d, 0x38, 0x2d, 0xd8, 0xcf, 0xaa, 0x9f, 0xdd, 0x5d, 0xd2,
-	0x31, 0x26, 0x2d, 0xf2, 0x62, 0x4d, 0xdb, 0xae, 0x64, 0xdb, 0x3a, 0x5f, 0xe1, 0x51, 0x0b, 0xa5,
-	0xde, 0x0e, 0x71, 0x76, 0x77, 0xaf, 0xf8, 0x17, 0x5a, 0x99, 0x11, 0x8a, 0xf9, 0x23, 0x94, 0xb2,
-	0x23, 0x7c, 0x82, 0x9d, 0xb4, 0xf3, 0xbf, 0x4d, 0xe7, 0x9b, 0x05, 0xbb, 0x6f, 0x91, 0x8e, 0xe4,
-	0xa0, 0x39, 0xc0, 0xde, 0xd0, 0x43, 0x31, 0xe1, 0xa1, 0x40, 0x72, 0x0a, 0xb6, 0x90, 0x54, 0x4e,
-	0x85, 0x52, 0xdf, 0x3a, 0x7e, 0xec, 0xc6, 0x7f, 0xf1, 0x1c, 0xa6, 0xdb, 0x51, 0x34, 0xcf, 0xd0,
-	0x9d, 0x97, 0x60, 0x6b, 0x84, 0xfc, 0x07, 0xeb, 0x9d, 0x6e, 0xc3, 0xeb, 0xb6, 0x2f, 0x5b, 0xdb,
-	0x05, 0x02, 0x60, 0x37, 0x9a, 0xdd, 0xf6, 0xd5, 0xc5, 0xb6, 0x15, 0x9f, 0xb4, 0x2f, 0x4d, 0xb5,
-	0xe2, 0x34, 0x61, 0x27, 0x93, 0xa5, 0xe9, 0xef, 0x02, 0xf4, 0xd2, 0x7f, 0xbe, 0x75, 0x58, 0xac,
-	0x6f, 0x1e, 0x6f, 0x29, 0x0f, 0x29, 0x37, 0xc3, 0x70, 0x4e, 0x61, 0x4b, 0xe7, 0x73, 0xaf, 0xf0,
-	0x0c, 0xd6, 0xae, 0x75, 0x62, 0xe6, 0xf3, 0x4d, 0xf5, 0xb9, 0x61, 0x25, 0x67, 0xc7, 0x3f, 0x8b,
-	0x00, 0x8d, 0xf7, 0xed, 0x0e, 0x46, 0x37, 0xac, 0x87, 0xe4, 0x04, 0xd6, 0x02, 0x94, 0xfa, 0x65,
-	0x72, 0xf5, 0x2b, 0xe6, 0x26, 0xaf, 0x98, 0x7b, 0x11, 0xbf, 0x62, 0x35, 0x63, 0x23, 0x79, 0xc1,
-	0x9c, 0x02, 0x39, 0x87, 0x72, 0x90, 0x5d, 0x60, 0xb2, 0xaf, 0x28, 0x79, 0x4b, 0x5d, 0xab, 0xcc,
-	0x0d, 0x61, 0xec, 0x3a, 0x05, 0xf2, 0x06, 0x48, 0xb0, 0xb0, 0xa8, 0xe4, 0x60, 0x41, 0x6a, 0x66,
-	0x83, 0x6b, 0x73, 0xa1, 0x38, 0x05, 0xf2, 0x01, 0x1e, 0x04, 0x79, 0x7b, 0x4a, 0x9e, 0x24, 0x52,
-	0x4b, 0x77, 0xb8, 0xb6, 0x9b, 0xcd, 0x28, 0xb5, 0xf6, 0x0a, 0x20, 0x95, 0x24, 0x95, 0x39, 0x9d,
-	0x3f, 0x7c, 0xfc, 0x1a, 0xd6, 0x99, 0xd0, 0x9b, 0xb3, 0x34, 0xd3, 0xea, 0xb2, 0xf5, 0x72, 0x0a,
-	0xd7, 0xb6, 0xe2, 0x9e, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xba, 0x07, 0x5f, 0x89, 0x65, 0x06,
-	0x00, 0x00,
+func (m *VoterResponse) GetDelegates() []*VotedDelegate {
+	if m != nil {
+		return m.Delegates
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *VoterResponse) GetVoterIoAddress() string {
+	if m != nil {
+		return m.VoterIoAddress
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *VoterResponse) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
 
-// APIServiceClient is the client API for APIService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type APIServiceClient interface {
-	// get the blockchain meta data
-	GetMeta(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ChainMeta, error)
-	// get candidates
-	GetCandidates(ctx context.Context, in *GetCandidatesRequest, opts ...grpc.CallOption) (*CandidateResponse, error)
-	// get candidate by name
-	GetCandidateByName(ctx context.Context, in *GetCandidateByNameRequest, opts ...grpc.CallOption) (*Candidate, error)
-	// get buckets by candidate
-	GetBucketsByCandidate(ctx context.Context, in *GetBucketsByCandidateRequest, opts ...grpc.CallOption) (*BucketResponse, error)
-	// get Buckets
-	GetBuckets(ctx context.Context, in *GetBucketsRequest, opts ...grpc.CallOption) (*BucketResponse, error)
-	// health endpoint
-	IsHealth(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+type GetStatisticsRequest struct {
+	Height               string   `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aPIServiceClient struct {
-	cc *grpc.ClientConn
+func (m *GetStatisticsRequest) Reset()         { *m = GetStatisticsRequest{} }
+func (m *GetStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStatisticsRequest) ProtoMessage()    {}
+func (*GetStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
 }
 
-func NewAPIServiceClient(cc *grpc.ClientConn) APIServiceClient {
-	return &aPIServiceClient{cc}
+func (m *GetStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStatisticsRequest.Unmarshal(m, b)
+}
+func (m *GetStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStatisticsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStatisticsRequest.Merge(m, src)
+}
+func (m *GetStatisticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStatisticsRequest.Size(m)
+}
+func (m *GetStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStatisticsRequest.DiscardUnknown(m)
 }
 
-func (c *aPIServiceClient) GetMeta(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ChainMeta, error) {
-	out := new(ChainMeta)
-	err := c.cc.Invoke(ctx, "/api.APIService/getMeta", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetStatisticsRequest proto.InternalMessageInfo
+
+func (m *GetStatisticsRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIServiceClient) GetCandidates(ctx context.Context, in *GetCandidatesRequest, opts ...grpc.CallOption) (*CandidateResponse, error) {
-	out := new(CandidateResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/getCandidates", in, out, opts...)
+type TopVoterShare struct {
+	Top                  uint32   `protobuf:"varint,1,opt,name=top,proto3" json:"top,omitempty"`
+	Share                float64  `protobuf:"fixed64,2,opt,name=share,proto3" json:"share,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TopVoterShare) Reset()         { *m = TopVoterShare{} }
+func (m *TopVoterShare) String() string { return proto.CompactTextString(m) }
+func (*TopVoterShare) ProtoMessage()    {}
+func (*TopVoterShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
+}
+
+func (m *TopVoterShare) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopVoterShare.Unmarshal(m, b)
+}
+func (m *TopVoterShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopVoterShare.Marshal(b, m, deterministic)
+}
+func (m *TopVoterShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopVoterShare.Merge(m, src)
+}
+func (m *TopVoterShare) XXX_Size() int {
+	return xxx_messageInfo_TopVoterShare.Size(m)
+}
+func (m *TopVoterShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopVoterShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopVoterShare proto.InternalMessageInfo
+
+func (m *TopVoterShare) GetTop() uint32 {
+	if m != nil {
+		return m.Top
+	}
+	return 0
+}
+
+func (m *TopVoterShare) GetShare() float64 {
+	if m != nil {
+		return m.Share
+	}
+	return 0
+}
+
+type DelegateVoters struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueVoters         uint32   `protobuf:"varint,2,opt,name=uniqueVoters,proto3" json:"uniqueVoters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelegateVoters) Reset()         { *m = DelegateVoters{} }
+func (m *DelegateVoters) String() string { return proto.CompactTextString(m) }
+func (*DelegateVoters) ProtoMessage()    {}
+func (*DelegateVoters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+}
+
+func (m *DelegateVoters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegateVoters.Unmarshal(m, b)
+}
+func (m *DelegateVoters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegateVoters.Marshal(b, m, deterministic)
+}
+func (m *DelegateVoters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateVoters.Merge(m, src)
+}
+func (m *DelegateVoters) XXX_Size() int {
+	return xxx_messageInfo_DelegateVoters.Size(m)
+}
+func (m *DelegateVoters) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateVoters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateVoters proto.InternalMessageInfo
+
+func (m *DelegateVoters) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DelegateVoters) GetUniqueVoters() uint32 {
+	if m != nil {
+		return m.UniqueVoters
+	}
+	return 0
+}
+
+type Statistics struct {
+	Height string `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	// the minimum number of delegates whose scores add up to more than half of the total
+	NakamotoCoefficient uint32 `protobuf:"varint,2,opt,name=nakamotoCoefficient,proto3" json:"nakamotoCoefficient,omitempty"`
+	// the gini coefficient of voter stakes
+	VoterGini            float64           `protobuf:"fixed64,3,opt,name=voterGini,proto3" json:"voterGini,omitempty"`
+	TopVoterShares       []*TopVoterShare  `protobuf:"bytes,4,rep,name=topVoterShares,proto3" json:"topVoterShares,omitempty"`
+	DelegateVoters       []*DelegateVoters `protobuf:"bytes,5,rep,name=delegateVoters,proto3" json:"delegateVoters,omitempty"`
+	SelfStakingShare     float64           `protobuf:"fixed64,6,opt,name=selfStakingShare,proto3" json:"selfStakingShare,omitempty"`
+	TotalVoters          uint32            `protobuf:"varint,7,opt,name=totalVoters,proto3" json:"totalVoters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Statistics) Reset()         { *m = Statistics{} }
+func (m *Statistics) String() string { return proto.CompactTextString(m) }
+func (*Statistics) ProtoMessage()    {}
+func (*Statistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+}
+
+func (m *Statistics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Statistics.Unmarshal(m, b)
+}
+func (m *Statistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Statistics.Marshal(b, m, deterministic)
+}
+func (m *Statistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Statistics.Merge(m, src)
+}
+func (m *Statistics) XXX_Size() int {
+	return xxx_messageInfo_Statistics.Size(m)
+}
+func (m *Statistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_Statistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Statistics proto.InternalMessageInfo
+
+func (m *Statistics) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *Statistics) GetNakamotoCoefficient() uint32 {
+	if m != nil {
+		return m.NakamotoCoefficient
+	}
+	return 0
+}
+
+func (m *Statistics) GetVoterGini() float64 {
+	if m != nil {
+		return m.VoterGini
+	}
+	return 0
+}
+
+func (m *Statistics) GetTopVoterShares() []*TopVoterShare {
+	if m != nil {
+		return m.TopVoterShares
+	}
+	return nil
+}
+
+func (m *Statistics) GetDelegateVoters() []*DelegateVoters {
+	if m != nil {
+		return m.DelegateVoters
+	}
+	return nil
+}
+
+func (m *Statistics) GetSelfStakingShare() float64 {
+	if m != nil {
+		return m.SelfStakingShare
+	}
+	return 0
+}
+
+func (m *Statistics) GetTotalVoters() uint32 {
+	if m != nil {
+		return m.TotalVoters
+	}
+	return 0
+}
+
+type HealthCheckResponse struct {
+	Status HealthCheckResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=api.HealthCheckResponse_Status" json:"status,omitempty"`
+	// the heights failed to be synced, which are being retried. The status is not affected by them,
+	// and the committee is degraded if any.
+	Failures             []*ConsistencyFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *HealthCheckResponse) Reset()         { *m = HealthCheckResponse{} }
+func (m *HealthCheckResponse) String() string { return proto.CompactTextString(m) }
+func (*HealthCheckResponse) ProtoMessage()    {}
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
+}
+
+func (m *HealthCheckResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthCheckResponse.Unmarshal(m, b)
+}
+func (m *HealthCheckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthCheckResponse.Marshal(b, m, deterministic)
+}
+func (m *HealthCheckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthCheckResponse.Merge(m, src)
+}
+func (m *HealthCheckResponse) XXX_Size() int {
+	return xxx_messageInfo_HealthCheckResponse.Size(m)
+}
+func (m *HealthCheckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthCheckResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthCheckResponse proto.InternalMessageInfo
+
+func (m *HealthCheckResponse) GetStatus() HealthCheckResponse_Status {
+	if m != nil {
+		return m.Status
+	}
+	return HealthCheckResponse_STARTING
+}
+
+func (m *HealthCheckResponse) GetFailures() []*ConsistencyFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+type ConsistencyFailure struct {
+	Height               string               `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	Cause                string               `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`
+	Attempts             uint32               `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	FirstFailure         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=firstFailure,proto3" json:"firstFailure,omitempty"`
+	LastFailure          *timestamp.Timestamp `protobuf:"bytes,5,opt,name=lastFailure,proto3" json:"lastFailure,omitempty"`
+	NextRetry            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=nextRetry,proto3" json:"nextRetry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ConsistencyFailure) Reset()         { *m = ConsistencyFailure{} }
+func (m *ConsistencyFailure) String() string { return proto.CompactTextString(m) }
+func (*ConsistencyFailure) ProtoMessage()    {}
+func (*ConsistencyFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+}
+
+func (m *ConsistencyFailure) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsistencyFailure.Unmarshal(m, b)
+}
+func (m *ConsistencyFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsistencyFailure.Marshal(b, m, deterministic)
+}
+func (m *ConsistencyFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsistencyFailure.Merge(m, src)
+}
+func (m *ConsistencyFailure) XXX_Size() int {
+	return xxx_messageInfo_ConsistencyFailure.Size(m)
+}
+func (m *ConsistencyFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsistencyFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsistencyFailure proto.InternalMessageInfo
+
+func (m *ConsistencyFailure) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *ConsistencyFailure) GetCause() string {
+	if m != nil {
+		return m.Cause
+	}
+	return ""
+}
+
+func (m *ConsistencyFailure) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *ConsistencyFailure) GetFirstFailure() *timestamp.Timestamp {
+	if m != nil {
+		return m.FirstFailure
+	}
+	return nil
+}
+
+func (m *ConsistencyFailure) GetLastFailure() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastFailure
+	}
+	return nil
+}
+
+func (m *ConsistencyFailure) GetNextRetry() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextRetry
+	}
+	return nil
+}
+
+type SyncStatus struct {
+	Status           HealthCheckResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=api.HealthCheckResponse_Status" json:"status,omitempty"`
+	TipHeight        string                     `protobuf:"bytes,2,opt,name=tipHeight,proto3" json:"tipHeight,omitempty"`
+	TipTime          *timestamp.Timestamp       `protobuf:"bytes,3,opt,name=tipTime,proto3" json:"tipTime,omitempty"`
+	LastSyncedHeight string                     `protobuf:"bytes,4,opt,name=lastSyncedHeight,proto3" json:"lastSyncedHeight,omitempty"`
+	LastSyncedTime   *timestamp.Timestamp       `protobuf:"bytes,5,opt,name=lastSyncedTime,proto3" json:"lastSyncedTime,omitempty"`
+	// number of gravity chain blocks behind the tip
+	LagHeights  uint64             `protobuf:"varint,6,opt,name=lagHeights,proto3" json:"lagHeights,omitempty"`
+	LagDuration *duration.Duration `protobuf:"bytes,7,opt,name=lagDuration,proto3" json:"lagDuration,omitempty"`
+	// number of heights waiting to be synced
+	PendingHeights uint64 `protobuf:"varint,8,opt,name=pendingHeights,proto3" json:"pendingHeights,omitempty"`
+	// the quarantined heights with the last error of each
+	Failures []*ConsistencyFailure `protobuf:"bytes,9,rep,name=failures,proto3" json:"failures,omitempty"`
+	// the gravity chain api in use
+	Endpoint            string `protobuf:"bytes,10,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	CatchUpStartHeight  string `protobuf:"bytes,11,opt,name=catchUpStartHeight,proto3" json:"catchUpStartHeight,omitempty"`
+	CatchUpTargetHeight string `protobuf:"bytes,12,opt,name=catchUpTargetHeight,proto3" json:"catchUpTargetHeight,omitempty"`
+	// ratio of synced heights in the catch-up range
+	CatchUpProgress      float64  `protobuf:"fixed64,13,opt,name=catchUpProgress,proto3" json:"catchUpProgress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncStatus) Reset()         { *m = SyncStatus{} }
+func (m *SyncStatus) String() string { return proto.CompactTextString(m) }
+func (*SyncStatus) ProtoMessage()    {}
+func (*SyncStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+}
+
+func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncStatus.Unmarshal(m, b)
+}
+func (m *SyncStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncStatus.Marshal(b, m, deterministic)
+}
+func (m *SyncStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStatus.Merge(m, src)
+}
+func (m *SyncStatus) XXX_Size() int {
+	return xxx_messageInfo_SyncStatus.Size(m)
+}
+func (m *SyncStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
+
+func (m *SyncStatus) GetStatus() HealthCheckResponse_Status {
+	if m != nil {
+		return m.Status
+	}
+	return HealthCheckResponse_STARTING
+}
+
+func (m *SyncStatus) GetTipHeight() string {
+	if m != nil {
+		return m.TipHeight
+	}
+	return ""
+}
+
+func (m *SyncStatus) GetTipTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.TipTime
+	}
+	return nil
+}
+
+func (m *SyncStatus) GetLastSyncedHeight() string {
+	if m != nil {
+		return m.LastSyncedHeight
+	}
+	return ""
+}
+
+func (m *SyncStatus) GetLastSyncedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSyncedTime
+	}
+	return nil
+}
+
+func (m *SyncStatus) GetLagHeights() uint64 {
+	if m != nil {
+		return m.LagHeights
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetLagDuration() *duration.Duration {
+	if m != nil {
+		return m.LagDuration
+	}
+	return nil
+}
+
+func (m *SyncStatus) GetPendingHeights() uint64 {
+	if m != nil {
+		return m.PendingHeights
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetFailures() []*ConsistencyFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+func (m *SyncStatus) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *SyncStatus) GetCatchUpStartHeight() string {
+	if m != nil {
+		return m.CatchUpStartHeight
+	}
+	return ""
+}
+
+func (m *SyncStatus) GetCatchUpTargetHeight() string {
+	if m != nil {
+		return m.CatchUpTargetHeight
+	}
+	return ""
+}
+
+func (m *SyncStatus) GetCatchUpProgress() float64 {
+	if m != nil {
+		return m.CatchUpProgress
+	}
+	return 0
+}
+
+type CandidateResponse struct {
+	Candidates []*Candidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// the resolved height
+	Height               string   `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CandidateResponse) Reset()         { *m = CandidateResponse{} }
+func (m *CandidateResponse) String() string { return proto.CompactTextString(m) }
+func (*CandidateResponse) ProtoMessage()    {}
+func (*CandidateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+}
+
+func (m *CandidateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateResponse.Unmarshal(m, b)
+}
+func (m *CandidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateResponse.Marshal(b, m, deterministic)
+}
+func (m *CandidateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateResponse.Merge(m, src)
+}
+func (m *CandidateResponse) XXX_Size() int {
+	return xxx_messageInfo_CandidateResponse.Size(m)
+}
+func (m *CandidateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateResponse proto.InternalMessageInfo
+
+func (m *CandidateResponse) GetCandidates() []*Candidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func (m *CandidateResponse) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+type BucketResponse struct {
+	Buckets []*Bucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// the cursor of the next page, empty if there is no more buckets
+	NextCursor string `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	// the resolved height
+	Height               string   `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketResponse) Reset()         { *m = BucketResponse{} }
+func (m *BucketResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketResponse) ProtoMessage()    {}
+func (*BucketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+}
+
+func (m *BucketResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketResponse.Unmarshal(m, b)
+}
+func (m *BucketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketResponse.Merge(m, src)
+}
+func (m *BucketResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketResponse.Size(m)
+}
+func (m *BucketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketResponse proto.InternalMessageInfo
+
+func (m *BucketResponse) GetBuckets() []*Bucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *BucketResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *BucketResponse) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+type GetResultRootRequest struct {
+	Height               string   `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetResultRootRequest) Reset()         { *m = GetResultRootRequest{} }
+func (m *GetResultRootRequest) String() string { return proto.CompactTextString(m) }
+func (*GetResultRootRequest) ProtoMessage()    {}
+func (*GetResultRootRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+}
+
+func (m *GetResultRootRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResultRootRequest.Unmarshal(m, b)
+}
+func (m *GetResultRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResultRootRequest.Marshal(b, m, deterministic)
+}
+func (m *GetResultRootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResultRootRequest.Merge(m, src)
+}
+func (m *GetResultRootRequest) XXX_Size() int {
+	return xxx_messageInfo_GetResultRootRequest.Size(m)
+}
+func (m *GetResultRootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResultRootRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResultRootRequest proto.InternalMessageInfo
+
+func (m *GetResultRootRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+type ResultRoot struct {
+	Height string `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	// hex string
+	Root string `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// hex string
+	DelegatesRoot string `protobuf:"bytes,3,opt,name=delegatesRoot,proto3" json:"delegatesRoot,omitempty"`
+	// hex string
+	VotesRoot            string   `protobuf:"bytes,4,opt,name=votesRoot,proto3" json:"votesRoot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResultRoot) Reset()         { *m = ResultRoot{} }
+func (m *ResultRoot) String() string { return proto.CompactTextString(m) }
+func (*ResultRoot) ProtoMessage()    {}
+func (*ResultRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+}
+
+func (m *ResultRoot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResultRoot.Unmarshal(m, b)
+}
+func (m *ResultRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResultRoot.Marshal(b, m, deterministic)
+}
+func (m *ResultRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultRoot.Merge(m, src)
+}
+func (m *ResultRoot) XXX_Size() int {
+	return xxx_messageInfo_ResultRoot.Size(m)
+}
+func (m *ResultRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResultRoot proto.InternalMessageInfo
+
+func (m *ResultRoot) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *ResultRoot) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *ResultRoot) GetDelegatesRoot() string {
+	if m != nil {
+		return m.DelegatesRoot
+	}
+	return ""
+}
+
+func (m *ResultRoot) GetVotesRoot() string {
+	if m != nil {
+		return m.VotesRoot
+	}
+	return ""
+}
+
+type GetCandidateProofRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Height               string   `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCandidateProofRequest) Reset()         { *m = GetCandidateProofRequest{} }
+func (m *GetCandidateProofRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCandidateProofRequest) ProtoMessage()    {}
+func (*GetCandidateProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+}
+
+func (m *GetCandidateProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCandidateProofRequest.Unmarshal(m, b)
+}
+func (m *GetCandidateProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCandidateProofRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCandidateProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCandidateProofRequest.Merge(m, src)
+}
+func (m *GetCandidateProofRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCandidateProofRequest.Size(m)
+}
+func (m *GetCandidateProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCandidateProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCandidateProofRequest proto.InternalMessageInfo
+
+func (m *GetCandidateProofRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetCandidateProofRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+type GetBucketProofRequest struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Height string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	// index of the bucket in the buckets of the candidate
+	Index                uint32   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBucketProofRequest) Reset()         { *m = GetBucketProofRequest{} }
+func (m *GetBucketProofRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketProofRequest) ProtoMessage()    {}
+func (*GetBucketProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+}
+
+func (m *GetBucketProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketProofRequest.Unmarshal(m, b)
+}
+func (m *GetBucketProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketProofRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBucketProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketProofRequest.Merge(m, src)
+}
+func (m *GetBucketProofRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketProofRequest.Size(m)
+}
+func (m *GetBucketProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketProofRequest proto.InternalMessageInfo
+
+func (m *GetBucketProofRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetBucketProofRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *GetBucketProofRequest) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ProofStep struct {
+	// hex string
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// true if the sibling is the left child
+	Left                 bool     `protobuf:"varint,2,opt,name=left,proto3" json:"left,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProofStep) Reset()         { *m = ProofStep{} }
+func (m *ProofStep) String() string { return proto.CompactTextString(m) }
+func (*ProofStep) ProtoMessage()    {}
+func (*ProofStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+}
+
+func (m *ProofStep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProofStep.Unmarshal(m, b)
+}
+func (m *ProofStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProofStep.Marshal(b, m, deterministic)
+}
+func (m *ProofStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofStep.Merge(m, src)
+}
+func (m *ProofStep) XXX_Size() int {
+	return xxx_messageInfo_ProofStep.Size(m)
+}
+func (m *ProofStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofStep proto.InternalMessageInfo
+
+func (m *ProofStep) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ProofStep) GetLeft() bool {
+	if m != nil {
+		return m.Left
+	}
+	return false
+}
+
+type MerkleProof struct {
+	// hex string of the root
+	Root string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// hex string of the serialized candidate or vote
+	Leaf  string       `protobuf:"bytes,2,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	Steps []*ProofStep `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
+	// the resolved height
+	Height               string   `protobuf:"bytes,4,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MerkleProof) Reset()         { *m = MerkleProof{} }
+func (m *MerkleProof) String() string { return proto.CompactTextString(m) }
+func (*MerkleProof) ProtoMessage()    {}
+func (*MerkleProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+}
+
+func (m *MerkleProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerkleProof.Unmarshal(m, b)
+}
+func (m *MerkleProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerkleProof.Marshal(b, m, deterministic)
+}
+func (m *MerkleProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleProof.Merge(m, src)
+}
+func (m *MerkleProof) XXX_Size() int {
+	return xxx_messageInfo_MerkleProof.Size(m)
+}
+func (m *MerkleProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleProof proto.InternalMessageInfo
+
+func (m *MerkleProof) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *MerkleProof) GetLeaf() string {
+	if m != nil {
+		return m.Leaf
+	}
+	return ""
+}
+
+func (m *MerkleProof) GetSteps() []*ProofStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+func (m *MerkleProof) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+type BucketSelector struct {
+	// hex or io1 string
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// hex string, empty for buckets of all candidates
+	Candidate            string   `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketSelector) Reset()         { *m = BucketSelector{} }
+func (m *BucketSelector) String() string { return proto.CompactTextString(m) }
+func (*BucketSelector) ProtoMessage()    {}
+func (*BucketSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+}
+
+func (m *BucketSelector) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSelector.Unmarshal(m, b)
+}
+func (m *BucketSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSelector.Marshal(b, m, deterministic)
+}
+func (m *BucketSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSelector.Merge(m, src)
+}
+func (m *BucketSelector) XXX_Size() int {
+	return xxx_messageInfo_BucketSelector.Size(m)
+}
+func (m *BucketSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSelector proto.InternalMessageInfo
+
+func (m *BucketSelector) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *BucketSelector) GetCandidate() string {
+	if m != nil {
+		return m.Candidate
+	}
+	return ""
+}
+
+type VoteMove struct {
+	Buckets *BucketSelector `protobuf:"bytes,1,opt,name=buckets,proto3" json:"buckets,omitempty"`
+	// hex string of the new candidate
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoteMove) Reset()         { *m = VoteMove{} }
+func (m *VoteMove) String() string { return proto.CompactTextString(m) }
+func (*VoteMove) ProtoMessage()    {}
+func (*VoteMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+}
+
+func (m *VoteMove) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteMove.Unmarshal(m, b)
+}
+func (m *VoteMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteMove.Marshal(b, m, deterministic)
+}
+func (m *VoteMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteMove.Merge(m, src)
+}
+func (m *VoteMove) XXX_Size() int {
+	return xxx_messageInfo_VoteMove.Size(m)
+}
+func (m *VoteMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteMove proto.InternalMessageInfo
+
+func (m *VoteMove) GetBuckets() *BucketSelector {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *VoteMove) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+type DurationChange struct {
+	Buckets              *BucketSelector    `protobuf:"bytes,1,opt,name=buckets,proto3" json:"buckets,omitempty"`
+	Duration             *duration.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DurationChange) Reset()         { *m = DurationChange{} }
+func (m *DurationChange) String() string { return proto.CompactTextString(m) }
+func (*DurationChange) ProtoMessage()    {}
+func (*DurationChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+}
+
+func (m *DurationChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DurationChange.Unmarshal(m, b)
+}
+func (m *DurationChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DurationChange.Marshal(b, m, deterministic)
+}
+func (m *DurationChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurationChange.Merge(m, src)
+}
+func (m *DurationChange) XXX_Size() int {
+	return xxx_messageInfo_DurationChange.Size(m)
+}
+func (m *DurationChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurationChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DurationChange proto.InternalMessageInfo
+
+func (m *DurationChange) GetBuckets() *BucketSelector {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *DurationChange) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+type SimulatedBucket struct {
+	// hex or io1 string
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// hex string
+	Candidate            string               `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Amount               string               `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	Duration             *duration.Duration   `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	Decay                bool                 `protobuf:"varint,6,opt,name=decay,proto3" json:"decay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SimulatedBucket) Reset()         { *m = SimulatedBucket{} }
+func (m *SimulatedBucket) String() string { return proto.CompactTextString(m) }
+func (*SimulatedBucket) ProtoMessage()    {}
+func (*SimulatedBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+}
+
+func (m *SimulatedBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulatedBucket.Unmarshal(m, b)
+}
+func (m *SimulatedBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulatedBucket.Marshal(b, m, deterministic)
+}
+func (m *SimulatedBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedBucket.Merge(m, src)
+}
+func (m *SimulatedBucket) XXX_Size() int {
+	return xxx_messageInfo_SimulatedBucket.Size(m)
+}
+func (m *SimulatedBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatedBucket proto.InternalMessageInfo
+
+func (m *SimulatedBucket) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *SimulatedBucket) GetCandidate() string {
+	if m != nil {
+		return m.Candidate
+	}
+	return ""
+}
+
+func (m *SimulatedBucket) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *SimulatedBucket) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *SimulatedBucket) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *SimulatedBucket) GetDecay() bool {
+	if m != nil {
+		return m.Decay
+	}
+	return false
+}
+
+type SimulateRequest struct {
+	Height          string             `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	RemoveBuckets   []*BucketSelector  `protobuf:"bytes,2,rep,name=removeBuckets,proto3" json:"removeBuckets,omitempty"`
+	MoveVotes       []*VoteMove        `protobuf:"bytes,3,rep,name=moveVotes,proto3" json:"moveVotes,omitempty"`
+	ChangeDurations []*DurationChange  `protobuf:"bytes,4,rep,name=changeDurations,proto3" json:"changeDurations,omitempty"`
+	AddBuckets      []*SimulatedBucket `protobuf:"bytes,5,rep,name=addBuckets,proto3" json:"addBuckets,omitempty"`
+	// thresholds are kept as configured if empty. Lowered thresholds admit the candidates and votes
+	// excluded from the result, and are refused if the exclusions have been pruned.
+	VoteThreshold        string   `protobuf:"bytes,6,opt,name=voteThreshold,proto3" json:"voteThreshold,omitempty"`
+	ScoreThreshold       string   `protobuf:"bytes,7,opt,name=scoreThreshold,proto3" json:"scoreThreshold,omitempty"`
+	SelfStakingThreshold string   `protobuf:"bytes,8,opt,name=selfStakingThreshold,proto3" json:"selfStakingThreshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimulateRequest) Reset()         { *m = SimulateRequest{} }
+func (m *SimulateRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateRequest) ProtoMessage()    {}
+func (*SimulateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+}
+
+func (m *SimulateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateRequest.Unmarshal(m, b)
+}
+func (m *SimulateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateRequest.Marshal(b, m, deterministic)
+}
+func (m *SimulateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateRequest.Merge(m, src)
+}
+func (m *SimulateRequest) XXX_Size() int {
+	return xxx_messageInfo_SimulateRequest.Size(m)
+}
+func (m *SimulateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateRequest proto.InternalMessageInfo
+
+func (m *SimulateRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *SimulateRequest) GetRemoveBuckets() []*BucketSelector {
+	if m != nil {
+		return m.RemoveBuckets
+	}
+	return nil
+}
+
+func (m *SimulateRequest) GetMoveVotes() []*VoteMove {
+	if m != nil {
+		return m.MoveVotes
+	}
+	return nil
+}
+
+func (m *SimulateRequest) GetChangeDurations() []*DurationChange {
+	if m != nil {
+		return m.ChangeDurations
+	}
+	return nil
+}
+
+func (m *SimulateRequest) GetAddBuckets() []*SimulatedBucket {
+	if m != nil {
+		return m.AddBuckets
+	}
+	return nil
+}
+
+func (m *SimulateRequest) GetVoteThreshold() string {
+	if m != nil {
+		return m.VoteThreshold
+	}
+	return ""
+}
+
+func (m *SimulateRequest) GetScoreThreshold() string {
+	if m != nil {
+		return m.ScoreThreshold
+	}
+	return ""
+}
+
+func (m *SimulateRequest) GetSelfStakingThreshold() string {
+	if m != nil {
+		return m.SelfStakingThreshold
+	}
+	return ""
+}
+
+type DelegateChange struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 1-based rank, 0 if not a delegate
+	OldRank              uint32   `protobuf:"varint,2,opt,name=oldRank,proto3" json:"oldRank,omitempty"`
+	NewRank              uint32   `protobuf:"varint,3,opt,name=newRank,proto3" json:"newRank,omitempty"`
+	OldVotes             string   `protobuf:"bytes,4,opt,name=oldVotes,proto3" json:"oldVotes,omitempty"`
+	NewVotes             string   `protobuf:"bytes,5,opt,name=newVotes,proto3" json:"newVotes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelegateChange) Reset()         { *m = DelegateChange{} }
+func (m *DelegateChange) String() string { return proto.CompactTextString(m) }
+func (*DelegateChange) ProtoMessage()    {}
+func (*DelegateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
+}
+
+func (m *DelegateChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegateChange.Unmarshal(m, b)
+}
+func (m *DelegateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegateChange.Marshal(b, m, deterministic)
+}
+func (m *DelegateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateChange.Merge(m, src)
+}
+func (m *DelegateChange) XXX_Size() int {
+	return xxx_messageInfo_DelegateChange.Size(m)
+}
+func (m *DelegateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateChange proto.InternalMessageInfo
+
+func (m *DelegateChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DelegateChange) GetOldRank() uint32 {
+	if m != nil {
+		return m.OldRank
+	}
+	return 0
+}
+
+func (m *DelegateChange) GetNewRank() uint32 {
+	if m != nil {
+		return m.NewRank
+	}
+	return 0
+}
+
+func (m *DelegateChange) GetOldVotes() string {
+	if m != nil {
+		return m.OldVotes
+	}
+	return ""
+}
+
+func (m *DelegateChange) GetNewVotes() string {
+	if m != nil {
+		return m.NewVotes
+	}
+	return ""
+}
+
+type SimulateResponse struct {
+	// the simulated ranking
+	Candidates []*Candidate      `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Changes    []*DelegateChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	// the resolved height
+	Height               string   `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimulateResponse) Reset()         { *m = SimulateResponse{} }
+func (m *SimulateResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateResponse) ProtoMessage()    {}
+func (*SimulateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
+}
+
+func (m *SimulateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateResponse.Unmarshal(m, b)
+}
+func (m *SimulateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateResponse.Marshal(b, m, deterministic)
+}
+func (m *SimulateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateResponse.Merge(m, src)
+}
+func (m *SimulateResponse) XXX_Size() int {
+	return xxx_messageInfo_SimulateResponse.Size(m)
+}
+func (m *SimulateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateResponse proto.InternalMessageInfo
+
+func (m *SimulateResponse) GetCandidates() []*Candidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func (m *SimulateResponse) GetChanges() []*DelegateChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *SimulateResponse) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+type GetExclusionsRequest struct {
+	Height string `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	// hex string, empty for exclusions of all candidates
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExclusionsRequest) Reset()         { *m = GetExclusionsRequest{} }
+func (m *GetExclusionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExclusionsRequest) ProtoMessage()    {}
+func (*GetExclusionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
+}
+
+func (m *GetExclusionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExclusionsRequest.Unmarshal(m, b)
+}
+func (m *GetExclusionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExclusionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExclusionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExclusionsRequest.Merge(m, src)
+}
+func (m *GetExclusionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExclusionsRequest.Size(m)
+}
+func (m *GetExclusionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExclusionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExclusionsRequest proto.InternalMessageInfo
+
+func (m *GetExclusionsRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *GetExclusionsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ExcludedCandidate struct {
+	Candidate            *Candidate      `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Reason               ExclusionReason `protobuf:"varint,2,opt,name=reason,proto3,enum=api.ExclusionReason" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ExcludedCandidate) Reset()         { *m = ExcludedCandidate{} }
+func (m *ExcludedCandidate) String() string { return proto.CompactTextString(m) }
+func (*ExcludedCandidate) ProtoMessage()    {}
+func (*ExcludedCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
+}
+
+func (m *ExcludedCandidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExcludedCandidate.Unmarshal(m, b)
+}
+func (m *ExcludedCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExcludedCandidate.Marshal(b, m, deterministic)
+}
+func (m *ExcludedCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExcludedCandidate.Merge(m, src)
+}
+func (m *ExcludedCandidate) XXX_Size() int {
+	return xxx_messageInfo_ExcludedCandidate.Size(m)
+}
+func (m *ExcludedCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExcludedCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExcludedCandidate proto.InternalMessageInfo
+
+func (m *ExcludedCandidate) GetCandidate() *Candidate {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+func (m *ExcludedCandidate) GetReason() ExclusionReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ExclusionReason_UNKNOWN_REASON
+}
+
+type ExcludedBucket struct {
+	// hex string
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// hex string
+	Candidate            string          `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Votes                string          `protobuf:"bytes,3,opt,name=votes,proto3" json:"votes,omitempty"`
+	Reason               ExclusionReason `protobuf:"varint,4,opt,name=reason,proto3,enum=api.ExclusionReason" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ExcludedBucket) Reset()         { *m = ExcludedBucket{} }
+func (m *ExcludedBucket) String() string { return proto.CompactTextString(m) }
+func (*ExcludedBucket) ProtoMessage()    {}
+func (*ExcludedBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
+}
+
+func (m *ExcludedBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExcludedBucket.Unmarshal(m, b)
+}
+func (m *ExcludedBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExcludedBucket.Marshal(b, m, deterministic)
+}
+func (m *ExcludedBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExcludedBucket.Merge(m, src)
+}
+func (m *ExcludedBucket) XXX_Size() int {
+	return xxx_messageInfo_ExcludedBucket.Size(m)
+}
+func (m *ExcludedBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExcludedBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExcludedBucket proto.InternalMessageInfo
+
+func (m *ExcludedBucket) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *ExcludedBucket) GetCandidate() string {
+	if m != nil {
+		return m.Candidate
+	}
+	return ""
+}
+
+func (m *ExcludedBucket) GetVotes() string {
+	if m != nil {
+		return m.Votes
+	}
+	return ""
+}
+
+func (m *ExcludedBucket) GetReason() ExclusionReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ExclusionReason_UNKNOWN_REASON
+}
+
+type ExclusionResponse struct {
+	Candidates []*ExcludedCandidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Buckets    []*ExcludedBucket    `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// true if the excluded buckets have been pruned
+	Pruned bool `protobuf:"varint,3,opt,name=pruned,proto3" json:"pruned,omitempty"`
+	// the candidates registered with malformed addresses, which are not excluded
+	Malformed []*Candidate `protobuf:"bytes,4,rep,name=malformed,proto3" json:"malformed,omitempty"`
+	// the resolved height
+	Height               string   `protobuf:"bytes,5,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExclusionResponse) Reset()         { *m = ExclusionResponse{} }
+func (m *ExclusionResponse) String() string { return proto.CompactTextString(m) }
+func (*ExclusionResponse) ProtoMessage()    {}
+func (*ExclusionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
+}
+
+func (m *ExclusionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExclusionResponse.Unmarshal(m, b)
+}
+func (m *ExclusionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExclusionResponse.Marshal(b, m, deterministic)
+}
+func (m *ExclusionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExclusionResponse.Merge(m, src)
+}
+func (m *ExclusionResponse) XXX_Size() int {
+	return xxx_messageInfo_ExclusionResponse.Size(m)
+}
+func (m *ExclusionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExclusionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExclusionResponse proto.InternalMessageInfo
+
+func (m *ExclusionResponse) GetCandidates() []*ExcludedCandidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func (m *ExclusionResponse) GetBuckets() []*ExcludedBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *ExclusionResponse) GetPruned() bool {
+	if m != nil {
+		return m.Pruned
+	}
+	return false
+}
+
+func (m *ExclusionResponse) GetMalformed() []*Candidate {
+	if m != nil {
+		return m.Malformed
+	}
+	return nil
+}
+
+func (m *ExclusionResponse) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+type GetBlockProducersRequest struct {
+	Height               string   `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	Epoch                uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockProducersRequest) Reset()         { *m = GetBlockProducersRequest{} }
+func (m *GetBlockProducersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockProducersRequest) ProtoMessage()    {}
+func (*GetBlockProducersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
+}
+
+func (m *GetBlockProducersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockProducersRequest.Unmarshal(m, b)
+}
+func (m *GetBlockProducersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockProducersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlockProducersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockProducersRequest.Merge(m, src)
+}
+func (m *GetBlockProducersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockProducersRequest.Size(m)
+}
+func (m *GetBlockProducersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockProducersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockProducersRequest proto.InternalMessageInfo
+
+func (m *GetBlockProducersRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *GetBlockProducersRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type BlockProducersResponse struct {
+	Height string `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	Epoch  uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// in rank order
+	ConsensusDelegates []*Candidate `protobuf:"bytes,3,rep,name=consensusDelegates,proto3" json:"consensusDelegates,omitempty"`
+	// in the order of producing blocks in the epoch
+	BlockProducers       []*Candidate `protobuf:"bytes,4,rep,name=blockProducers,proto3" json:"blockProducers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BlockProducersResponse) Reset()         { *m = BlockProducersResponse{} }
+func (m *BlockProducersResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockProducersResponse) ProtoMessage()    {}
+func (*BlockProducersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
+}
+
+func (m *BlockProducersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockProducersResponse.Unmarshal(m, b)
+}
+func (m *BlockProducersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockProducersResponse.Marshal(b, m, deterministic)
+}
+func (m *BlockProducersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockProducersResponse.Merge(m, src)
+}
+func (m *BlockProducersResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockProducersResponse.Size(m)
+}
+func (m *BlockProducersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockProducersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockProducersResponse proto.InternalMessageInfo
+
+func (m *BlockProducersResponse) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *BlockProducersResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *BlockProducersResponse) GetConsensusDelegates() []*Candidate {
+	if m != nil {
+		return m.ConsensusDelegates
+	}
+	return nil
+}
+
+func (m *BlockProducersResponse) GetBlockProducers() []*Candidate {
+	if m != nil {
+		return m.BlockProducers
+	}
+	return nil
+}
+
+type ProbationList struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// the percentage of score reduction, in which 100 excludes the delegates, and 0 for the default
+	IntensityRate uint32 `protobuf:"varint,2,opt,name=intensityRate,proto3" json:"intensityRate,omitempty"`
+	// hex strings of names
+	Delegates            []string `protobuf:"bytes,3,rep,name=delegates,proto3" json:"delegates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProbationList) Reset()         { *m = ProbationList{} }
+func (m *ProbationList) String() string { return proto.CompactTextString(m) }
+func (*ProbationList) ProtoMessage()    {}
+func (*ProbationList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
+}
+
+func (m *ProbationList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbationList.Unmarshal(m, b)
+}
+func (m *ProbationList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbationList.Marshal(b, m, deterministic)
+}
+func (m *ProbationList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbationList.Merge(m, src)
+}
+func (m *ProbationList) XXX_Size() int {
+	return xxx_messageInfo_ProbationList.Size(m)
+}
+func (m *ProbationList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbationList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbationList proto.InternalMessageInfo
+
+func (m *ProbationList) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ProbationList) GetIntensityRate() uint32 {
+	if m != nil {
+		return m.IntensityRate
+	}
+	return 0
+}
+
+func (m *ProbationList) GetDelegates() []string {
+	if m != nil {
+		return m.Delegates
+	}
+	return nil
+}
+
+type GetRankingsRequest struct {
+	Height               string   `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	Epoch                uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRankingsRequest) Reset()         { *m = GetRankingsRequest{} }
+func (m *GetRankingsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRankingsRequest) ProtoMessage()    {}
+func (*GetRankingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
+}
+
+func (m *GetRankingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRankingsRequest.Unmarshal(m, b)
+}
+func (m *GetRankingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRankingsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRankingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRankingsRequest.Merge(m, src)
+}
+func (m *GetRankingsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRankingsRequest.Size(m)
+}
+func (m *GetRankingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRankingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRankingsRequest proto.InternalMessageInfo
+
+func (m *GetRankingsRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *GetRankingsRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type Ranking struct {
+	// the candidate with raw score
+	Candidate *Candidate `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	RawRank   uint32     `protobuf:"varint,2,opt,name=rawRank,proto3" json:"rawRank,omitempty"`
+	// 0 if excluded
+	Rank                 uint32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	AdjustedScore        string   `protobuf:"bytes,4,opt,name=adjustedScore,proto3" json:"adjustedScore,omitempty"`
+	Probation            bool     `protobuf:"varint,5,opt,name=probation,proto3" json:"probation,omitempty"`
+	Excluded             bool     `protobuf:"varint,6,opt,name=excluded,proto3" json:"excluded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Ranking) Reset()         { *m = Ranking{} }
+func (m *Ranking) String() string { return proto.CompactTextString(m) }
+func (*Ranking) ProtoMessage()    {}
+func (*Ranking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
+}
+
+func (m *Ranking) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Ranking.Unmarshal(m, b)
+}
+func (m *Ranking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Ranking.Marshal(b, m, deterministic)
+}
+func (m *Ranking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ranking.Merge(m, src)
+}
+func (m *Ranking) XXX_Size() int {
+	return xxx_messageInfo_Ranking.Size(m)
+}
+func (m *Ranking) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ranking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ranking proto.InternalMessageInfo
+
+func (m *Ranking) GetCandidate() *Candidate {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+func (m *Ranking) GetRawRank() uint32 {
+	if m != nil {
+		return m.RawRank
+	}
+	return 0
+}
+
+func (m *Ranking) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *Ranking) GetAdjustedScore() string {
+	if m != nil {
+		return m.AdjustedScore
+	}
+	return ""
+}
+
+func (m *Ranking) GetProbation() bool {
+	if m != nil {
+		return m.Probation
+	}
+	return false
+}
+
+func (m *Ranking) GetExcluded() bool {
+	if m != nil {
+		return m.Excluded
+	}
+	return false
+}
+
+type RankingsResponse struct {
+	Height        string         `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	ProbationList *ProbationList `protobuf:"bytes,2,opt,name=probationList,proto3" json:"probationList,omitempty"`
+	// in the raw ranking order
+	Rankings             []*Ranking `protobuf:"bytes,3,rep,name=rankings,proto3" json:"rankings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *RankingsResponse) Reset()         { *m = RankingsResponse{} }
+func (m *RankingsResponse) String() string { return proto.CompactTextString(m) }
+func (*RankingsResponse) ProtoMessage()    {}
+func (*RankingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
+}
+
+func (m *RankingsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RankingsResponse.Unmarshal(m, b)
+}
+func (m *RankingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RankingsResponse.Marshal(b, m, deterministic)
+}
+func (m *RankingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RankingsResponse.Merge(m, src)
+}
+func (m *RankingsResponse) XXX_Size() int {
+	return xxx_messageInfo_RankingsResponse.Size(m)
+}
+func (m *RankingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RankingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RankingsResponse proto.InternalMessageInfo
+
+func (m *RankingsResponse) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *RankingsResponse) GetProbationList() *ProbationList {
+	if m != nil {
+		return m.ProbationList
+	}
+	return nil
+}
+
+func (m *RankingsResponse) GetRankings() []*Ranking {
+	if m != nil {
+		return m.Rankings
+	}
+	return nil
+}
+
+type StreamResultsRequest struct {
+	// the height to resume from, inclusive, "latest", or empty to stream the heights synced from now on
+	FromHeight string `protobuf:"bytes,1,opt,name=fromHeight,proto3" json:"fromHeight,omitempty"`
+	// the number of top delegates in the summaries, 36 if 0
+	TopDelegates         uint32   `protobuf:"varint,2,opt,name=topDelegates,proto3" json:"topDelegates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamResultsRequest) Reset()         { *m = StreamResultsRequest{} }
+func (m *StreamResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamResultsRequest) ProtoMessage()    {}
+func (*StreamResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
+}
+
+func (m *StreamResultsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamResultsRequest.Unmarshal(m, b)
+}
+func (m *StreamResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamResultsRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamResultsRequest.Merge(m, src)
+}
+func (m *StreamResultsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamResultsRequest.Size(m)
+}
+func (m *StreamResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamResultsRequest proto.InternalMessageInfo
+
+func (m *StreamResultsRequest) GetFromHeight() string {
+	if m != nil {
+		return m.FromHeight
+	}
+	return ""
+}
+
+func (m *StreamResultsRequest) GetTopDelegates() uint32 {
+	if m != nil {
+		return m.TopDelegates
+	}
+	return 0
+}
+
+type ResultSummary struct {
+	Height   string               `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	MintTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=mintTime,proto3" json:"mintTime,omitempty"`
+	// in rank order
+	TopDelegates         []*Candidate `protobuf:"bytes,3,rep,name=topDelegates,proto3" json:"topDelegates,omitempty"`
+	TotalVotes           string       `protobuf:"bytes,4,opt,name=totalVotes,proto3" json:"totalVotes,omitempty"`
+	TotalVotedStakes     string       `protobuf:"bytes,5,opt,name=totalVotedStakes,proto3" json:"totalVotedStakes,omitempty"`
+	TotalCandidates      uint64       `protobuf:"varint,6,opt,name=totalCandidates,proto3" json:"totalCandidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ResultSummary) Reset()         { *m = ResultSummary{} }
+func (m *ResultSummary) String() string { return proto.CompactTextString(m) }
+func (*ResultSummary) ProtoMessage()    {}
+func (*ResultSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
+}
+
+func (m *ResultSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResultSummary.Unmarshal(m, b)
+}
+func (m *ResultSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResultSummary.Marshal(b, m, deterministic)
+}
+func (m *ResultSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultSummary.Merge(m, src)
+}
+func (m *ResultSummary) XXX_Size() int {
+	return xxx_messageInfo_ResultSummary.Size(m)
+}
+func (m *ResultSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResultSummary proto.InternalMessageInfo
+
+func (m *ResultSummary) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *ResultSummary) GetMintTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.MintTime
+	}
+	return nil
+}
+
+func (m *ResultSummary) GetTopDelegates() []*Candidate {
+	if m != nil {
+		return m.TopDelegates
+	}
+	return nil
+}
+
+func (m *ResultSummary) GetTotalVotes() string {
+	if m != nil {
+		return m.TotalVotes
+	}
+	return ""
+}
+
+func (m *ResultSummary) GetTotalVotedStakes() string {
+	if m != nil {
+		return m.TotalVotedStakes
+	}
+	return ""
+}
+
+func (m *ResultSummary) GetTotalCandidates() uint64 {
+	if m != nil {
+		return m.TotalCandidates
+	}
+	return 0
+}
+
+type Heartbeat struct {
+	LatestHeight         string               `protobuf:"bytes,1,opt,name=latestHeight,proto3" json:"latestHeight,omitempty"`
+	Time                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Heartbeat) Reset()         { *m = Heartbeat{} }
+func (m *Heartbeat) String() string { return proto.CompactTextString(m) }
+func (*Heartbeat) ProtoMessage()    {}
+func (*Heartbeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
+}
+
+func (m *Heartbeat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Heartbeat.Unmarshal(m, b)
+}
+func (m *Heartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Heartbeat.Marshal(b, m, deterministic)
+}
+func (m *Heartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Heartbeat.Merge(m, src)
+}
+func (m *Heartbeat) XXX_Size() int {
+	return xxx_messageInfo_Heartbeat.Size(m)
+}
+func (m *Heartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_Heartbeat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Heartbeat proto.InternalMessageInfo
+
+func (m *Heartbeat) GetLatestHeight() string {
+	if m != nil {
+		return m.LatestHeight
+	}
+	return ""
+}
+
+func (m *Heartbeat) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+type ResultEvent struct {
+	// Types that are valid to be assigned to Event:
+	//	*ResultEvent_Result
+	//	*ResultEvent_Heartbeat
+	Event                isResultEvent_Event `protobuf_oneof:"event"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ResultEvent) Reset()         { *m = ResultEvent{} }
+func (m *ResultEvent) String() string { return proto.CompactTextString(m) }
+func (*ResultEvent) ProtoMessage()    {}
+func (*ResultEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
+}
+
+func (m *ResultEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResultEvent.Unmarshal(m, b)
+}
+func (m *ResultEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResultEvent.Marshal(b, m, deterministic)
+}
+func (m *ResultEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultEvent.Merge(m, src)
+}
+func (m *ResultEvent) XXX_Size() int {
+	return xxx_messageInfo_ResultEvent.Size(m)
+}
+func (m *ResultEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResultEvent proto.InternalMessageInfo
+
+type isResultEvent_Event interface {
+	isResultEvent_Event()
+}
+
+type ResultEvent_Result struct {
+	Result *ResultSummary `protobuf:"bytes,1,opt,name=result,proto3,oneof"`
+}
+
+type ResultEvent_Heartbeat struct {
+	Heartbeat *Heartbeat `protobuf:"bytes,2,opt,name=heartbeat,proto3,oneof"`
+}
+
+func (*ResultEvent_Result) isResultEvent_Event() {}
+
+func (*ResultEvent_Heartbeat) isResultEvent_Event() {}
+
+func (m *ResultEvent) GetEvent() isResultEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *ResultEvent) GetResult() *ResultSummary {
+	if x, ok := m.GetEvent().(*ResultEvent_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+func (m *ResultEvent) GetHeartbeat() *Heartbeat {
+	if x, ok := m.GetEvent().(*ResultEvent_Heartbeat); ok {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ResultEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ResultEvent_Result)(nil),
+		(*ResultEvent_Heartbeat)(nil),
+	}
+}
+
+type GetHeightByTimeRequest struct {
+	// in RFC 3339 format in the http query
+	Time                 *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetHeightByTimeRequest) Reset()         { *m = GetHeightByTimeRequest{} }
+func (m *GetHeightByTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHeightByTimeRequest) ProtoMessage()    {}
+func (*GetHeightByTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
+}
+
+func (m *GetHeightByTimeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHeightByTimeRequest.Unmarshal(m, b)
+}
+func (m *GetHeightByTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHeightByTimeRequest.Marshal(b, m, deterministic)
+}
+func (m *GetHeightByTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHeightByTimeRequest.Merge(m, src)
+}
+func (m *GetHeightByTimeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetHeightByTimeRequest.Size(m)
+}
+func (m *GetHeightByTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHeightByTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHeightByTimeRequest proto.InternalMessageInfo
+
+func (m *GetHeightByTimeRequest) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+type HeightResponse struct {
+	Height               string               `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	MintTime             *timestamp.Timestamp `protobuf:"bytes,2,opt,name=mintTime,proto3" json:"mintTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *HeightResponse) Reset()         { *m = HeightResponse{} }
+func (m *HeightResponse) String() string { return proto.CompactTextString(m) }
+func (*HeightResponse) ProtoMessage()    {}
+func (*HeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
+}
+
+func (m *HeightResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeightResponse.Unmarshal(m, b)
+}
+func (m *HeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeightResponse.Marshal(b, m, deterministic)
+}
+func (m *HeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeightResponse.Merge(m, src)
+}
+func (m *HeightResponse) XXX_Size() int {
+	return xxx_messageInfo_HeightResponse.Size(m)
+}
+func (m *HeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeightResponse proto.InternalMessageInfo
+
+func (m *HeightResponse) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *HeightResponse) GetMintTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.MintTime
+	}
+	return nil
+}
+
+type GetResultByTimeRequest struct {
+	// in RFC 3339 format in the http query
+	Time *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// the number of top delegates in the summary, 36 if 0
+	TopDelegates         uint32   `protobuf:"varint,2,opt,name=topDelegates,proto3" json:"topDelegates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetResultByTimeRequest) Reset()         { *m = GetResultByTimeRequest{} }
+func (m *GetResultByTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetResultByTimeRequest) ProtoMessage()    {}
+func (*GetResultByTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
+}
+
+func (m *GetResultByTimeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResultByTimeRequest.Unmarshal(m, b)
+}
+func (m *GetResultByTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResultByTimeRequest.Marshal(b, m, deterministic)
+}
+func (m *GetResultByTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResultByTimeRequest.Merge(m, src)
+}
+func (m *GetResultByTimeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetResultByTimeRequest.Size(m)
+}
+func (m *GetResultByTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResultByTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResultByTimeRequest proto.InternalMessageInfo
+
+func (m *GetResultByTimeRequest) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *GetResultByTimeRequest) GetTopDelegates() uint32 {
+	if m != nil {
+		return m.TopDelegates
+	}
+	return 0
+}
+
+type GetCandidateHistoryRequest struct {
+	// hex string
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the range is given by either heights or times, inclusive, with the end defaulting to the latest
+	StartHeight string `protobuf:"bytes,2,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	EndHeight   string `protobuf:"bytes,3,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+	// in RFC 3339 format in the http query
+	StartTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	// the number of synced heights between two points, 1 if 0
+	Step                 uint32   `protobuf:"varint,6,opt,name=step,proto3" json:"step,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCandidateHistoryRequest) Reset()         { *m = GetCandidateHistoryRequest{} }
+func (m *GetCandidateHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCandidateHistoryRequest) ProtoMessage()    {}
+func (*GetCandidateHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
+}
+
+func (m *GetCandidateHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCandidateHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetCandidateHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCandidateHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCandidateHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCandidateHistoryRequest.Merge(m, src)
+}
+func (m *GetCandidateHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCandidateHistoryRequest.Size(m)
+}
+func (m *GetCandidateHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCandidateHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCandidateHistoryRequest proto.InternalMessageInfo
+
+func (m *GetCandidateHistoryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetCandidateHistoryRequest) GetStartHeight() string {
+	if m != nil {
+		return m.StartHeight
+	}
+	return ""
+}
+
+func (m *GetCandidateHistoryRequest) GetEndHeight() string {
+	if m != nil {
+		return m.EndHeight
+	}
+	return ""
+}
+
+func (m *GetCandidateHistoryRequest) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *GetCandidateHistoryRequest) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *GetCandidateHistoryRequest) GetStep() uint32 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+type CandidateHistoryPoint struct {
+	Height   string               `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	MintTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=mintTime,proto3" json:"mintTime,omitempty"`
+	// 1-based rank in the result, 0 if the candidate is not in the result
+	Rank              uint32 `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Score             string `protobuf:"bytes,4,opt,name=score,proto3" json:"score,omitempty"`
+	SelfStakingTokens string `protobuf:"bytes,5,opt,name=selfStakingTokens,proto3" json:"selfStakingTokens,omitempty"`
+	// 0 if pruned is true
+	VoterCount uint32 `protobuf:"varint,6,opt,name=voterCount,proto3" json:"voterCount,omitempty"`
+	// true if the candidate is above the score and self staking thresholds
+	Qualified bool `protobuf:"varint,7,opt,name=qualified,proto3" json:"qualified,omitempty"`
+	// true if the votes of the result were pruned before its statistics were stored, so that the
+	// number of voters is unknown
+	Pruned               bool     `protobuf:"varint,8,opt,name=pruned,proto3" json:"pruned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CandidateHistoryPoint) Reset()         { *m = CandidateHistoryPoint{} }
+func (m *CandidateHistoryPoint) String() string { return proto.CompactTextString(m) }
+func (*CandidateHistoryPoint) ProtoMessage()    {}
+func (*CandidateHistoryPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
+}
+
+func (m *CandidateHistoryPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateHistoryPoint.Unmarshal(m, b)
+}
+func (m *CandidateHistoryPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateHistoryPoint.Marshal(b, m, deterministic)
+}
+func (m *CandidateHistoryPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateHistoryPoint.Merge(m, src)
+}
+func (m *CandidateHistoryPoint) XXX_Size() int {
+	return xxx_messageInfo_CandidateHistoryPoint.Size(m)
+}
+func (m *CandidateHistoryPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateHistoryPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateHistoryPoint proto.InternalMessageInfo
+
+func (m *CandidateHistoryPoint) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *CandidateHistoryPoint) GetMintTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.MintTime
+	}
+	return nil
+}
+
+func (m *CandidateHistoryPoint) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *CandidateHistoryPoint) GetScore() string {
+	if m != nil {
+		return m.Score
+	}
+	return ""
+}
+
+func (m *CandidateHistoryPoint) GetSelfStakingTokens() string {
+	if m != nil {
+		return m.SelfStakingTokens
+	}
+	return ""
+}
+
+func (m *CandidateHistoryPoint) GetVoterCount() uint32 {
+	if m != nil {
+		return m.VoterCount
+	}
+	return 0
+}
+
+func (m *CandidateHistoryPoint) GetQualified() bool {
+	if m != nil {
+		return m.Qualified
+	}
+	return false
+}
+
+func (m *CandidateHistoryPoint) GetPruned() bool {
+	if m != nil {
+		return m.Pruned
+	}
+	return false
+}
+
+type CandidateHistoryResponse struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// in height order
+	Points               []*CandidateHistoryPoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *CandidateHistoryResponse) Reset()         { *m = CandidateHistoryResponse{} }
+func (m *CandidateHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*CandidateHistoryResponse) ProtoMessage()    {}
+func (*CandidateHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
+}
+
+func (m *CandidateHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateHistoryResponse.Unmarshal(m, b)
+}
+func (m *CandidateHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *CandidateHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateHistoryResponse.Merge(m, src)
+}
+func (m *CandidateHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_CandidateHistoryResponse.Size(m)
+}
+func (m *CandidateHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateHistoryResponse proto.InternalMessageInfo
+
+func (m *CandidateHistoryResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CandidateHistoryResponse) GetPoints() []*CandidateHistoryPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("api.BucketSortKey", BucketSortKey_name, BucketSortKey_value)
+	proto.RegisterEnum("api.ExclusionReason", ExclusionReason_name, ExclusionReason_value)
+	proto.RegisterEnum("api.HealthCheckResponse_Status", HealthCheckResponse_Status_name, HealthCheckResponse_Status_value)
+	proto.RegisterType((*ChainMeta)(nil), "api.ChainMeta")
+	proto.RegisterType((*Bucket)(nil), "api.Bucket")
+	proto.RegisterType((*Candidate)(nil), "api.Candidate")
+	proto.RegisterType((*GetCandidatesRequest)(nil), "api.GetCandidatesRequest")
+	proto.RegisterType((*GetCandidateByNameRequest)(nil), "api.GetCandidateByNameRequest")
+	proto.RegisterType((*GetBucketsByCandidateRequest)(nil), "api.GetBucketsByCandidateRequest")
+	proto.RegisterType((*GetBucketsRequest)(nil), "api.GetBucketsRequest")
+	proto.RegisterType((*GetVoterRequest)(nil), "api.GetVoterRequest")
+	proto.RegisterType((*VotedDelegate)(nil), "api.VotedDelegate")
+	proto.RegisterType((*VoterResponse)(nil), "api.VoterResponse")
+	proto.RegisterType((*GetStatisticsRequest)(nil), "api.GetStatisticsRequest")
+	proto.RegisterType((*TopVoterShare)(nil), "api.TopVoterShare")
+	proto.RegisterType((*DelegateVoters)(nil), "api.DelegateVoters")
+	proto.RegisterType((*Statistics)(nil), "api.Statistics")
+	proto.RegisterType((*HealthCheckResponse)(nil), "api.HealthCheckResponse")
+	proto.RegisterType((*ConsistencyFailure)(nil), "api.ConsistencyFailure")
+	proto.RegisterType((*SyncStatus)(nil), "api.SyncStatus")
+	proto.RegisterType((*CandidateResponse)(nil), "api.CandidateResponse")
+	proto.RegisterType((*BucketResponse)(nil), "api.BucketResponse")
+	proto.RegisterType((*GetResultRootRequest)(nil), "api.GetResultRootRequest")
+	proto.RegisterType((*ResultRoot)(nil), "api.ResultRoot")
+	proto.RegisterType((*GetCandidateProofRequest)(nil), "api.GetCandidateProofRequest")
+	proto.RegisterType((*GetBucketProofRequest)(nil), "api.GetBucketProofRequest")
+	proto.RegisterType((*ProofStep)(nil), "api.ProofStep")
+	proto.RegisterType((*MerkleProof)(nil), "api.MerkleProof")
+	proto.RegisterType((*BucketSelector)(nil), "api.BucketSelector")
+	proto.RegisterType((*VoteMove)(nil), "api.VoteMove")
+	proto.RegisterType((*DurationChange)(nil), "api.DurationChange")
+	proto.RegisterType((*SimulatedBucket)(nil), "api.SimulatedBucket")
+	proto.RegisterType((*SimulateRequest)(nil), "api.SimulateRequest")
+	proto.RegisterType((*DelegateChange)(nil), "api.DelegateChange")
+	proto.RegisterType((*SimulateResponse)(nil), "api.SimulateResponse")
+	proto.RegisterType((*GetExclusionsRequest)(nil), "api.GetExclusionsRequest")
+	proto.RegisterType((*ExcludedCandidate)(nil), "api.ExcludedCandidate")
+	proto.RegisterType((*ExcludedBucket)(nil), "api.ExcludedBucket")
+	proto.RegisterType((*ExclusionResponse)(nil), "api.ExclusionResponse")
+	proto.RegisterType((*GetBlockProducersRequest)(nil), "api.GetBlockProducersRequest")
+	proto.RegisterType((*BlockProducersResponse)(nil), "api.BlockProducersResponse")
+	proto.RegisterType((*ProbationList)(nil), "api.ProbationList")
+	proto.RegisterType((*GetRankingsRequest)(nil), "api.GetRankingsRequest")
+	proto.RegisterType((*Ranking)(nil), "api.Ranking")
+	proto.RegisterType((*RankingsResponse)(nil), "api.RankingsResponse")
+	proto.RegisterType((*StreamResultsRequest)(nil), "api.StreamResultsRequest")
+	proto.RegisterType((*ResultSummary)(nil), "api.ResultSummary")
+	proto.RegisterType((*Heartbeat)(nil), "api.Heartbeat")
+	proto.RegisterType((*ResultEvent)(nil), "api.ResultEvent")
+	proto.RegisterType((*GetHeightByTimeRequest)(nil), "api.GetHeightByTimeRequest")
+	proto.RegisterType((*HeightResponse)(nil), "api.HeightResponse")
+	proto.RegisterType((*GetResultByTimeRequest)(nil), "api.GetResultByTimeRequest")
+	proto.RegisterType((*GetCandidateHistoryRequest)(nil), "api.GetCandidateHistoryRequest")
+	proto.RegisterType((*CandidateHistoryPoint)(nil), "api.CandidateHistoryPoint")
+	proto.RegisterType((*CandidateHistoryResponse)(nil), "api.CandidateHistoryResponse")
+}
+
+func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
+
+var fileDescriptor_00212fb1f9d3bf1c = []byte{
+	// 3239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x3a, 0x4d, 0x73, 0x1b, 0xc7,
+	0xb1, 0x5a, 0x80, 0x04, 0x81, 0xa6, 0x00, 0x82, 0x23, 0x8a, 0x82, 0x20, 0x59, 0x92, 0xf7, 0xc9,
+	0x7e, 0x2c, 0x59, 0x22, 0xf4, 0x68, 0x59, 0xf6, 0xb3, 0xfd, 0xfc, 0x0c, 0x02, 0x10, 0x89, 0xb2,
+	0x04, 0xaa, 0x16, 0x90, 0x95, 0x38, 0xa9, 0xd0, 0x4b, 0xec, 0x00, 0xd8, 0x10, 0xd8, 0x85, 0x77,
+	0x07, 0x92, 0x11, 0xd9, 0x97, 0x54, 0x2a, 0xb1, 0x2f, 0xae, 0x54, 0xe5, 0xa3, 0x2a, 0xff, 0x22,
+	0xf7, 0x54, 0x72, 0xf3, 0x21, 0xe7, 0x5c, 0x72, 0xc8, 0xd1, 0xb7, 0x1c, 0x93, 0x1f, 0x90, 0x9a,
+	0xaf, 0xdd, 0xd9, 0xc5, 0x42, 0xa0, 0x28, 0x9f, 0x88, 0xe9, 0xe9, 0xed, 0xef, 0xe9, 0xe9, 0xee,
+	0x21, 0xe4, 0xcc, 0xb1, 0xbd, 0x3d, 0xf6, 0x5c, 0xe2, 0xa2, 0xb4, 0x39, 0xb6, 0xcb, 0x97, 0xfb,
+	0xae, 0xdb, 0x1f, 0xe2, 0x8a, 0x39, 0xb6, 0x2b, 0xa6, 0xe3, 0xb8, 0xc4, 0x24, 0xb6, 0xeb, 0xf8,
+	0x1c, 0xa5, 0x7c, 0x45, 0xec, 0xb2, 0xd5, 0xd1, 0xa4, 0x57, 0xb1, 0x26, 0x1e, 0x43, 0x10, 0xfb,
+	0x97, 0xe2, 0xfb, 0x78, 0x34, 0x26, 0x53, 0xb1, 0x79, 0x35, 0xbe, 0x49, 0xec, 0x11, 0xf6, 0x89,
+	0x39, 0x1a, 0x73, 0x04, 0xfd, 0x0f, 0x1a, 0xe4, 0x6a, 0x03, 0xd3, 0x76, 0x1e, 0x60, 0x62, 0xa2,
+	0x4d, 0xc8, 0x0c, 0xb0, 0xdd, 0x1f, 0x90, 0x92, 0x76, 0x4d, 0xdb, 0xca, 0x19, 0x62, 0x85, 0xb6,
+	0x60, 0x8d, 0xb8, 0xc4, 0x1c, 0xd6, 0x4c, 0xc7, 0xb2, 0x2d, 0x93, 0x60, 0xbf, 0x94, 0xba, 0xa6,
+	0x6d, 0x2d, 0x19, 0x71, 0x30, 0xba, 0x01, 0x45, 0x06, 0xfa, 0xd8, 0x25, 0xd8, 0x6a, 0x13, 0xf3,
+	0x18, 0xfb, 0xa5, 0x34, 0xa3, 0x35, 0x03, 0x47, 0x57, 0x00, 0x02, 0x98, 0x5f, 0x5a, 0x62, 0x58,
+	0x0a, 0x44, 0xff, 0xa3, 0x06, 0x99, 0xdd, 0x49, 0xf7, 0x18, 0x13, 0xb4, 0x01, 0xcb, 0x4f, 0x5c,
+	0x82, 0x3d, 0x21, 0x17, 0x5f, 0x48, 0x28, 0x17, 0x46, 0x40, 0x7d, 0x74, 0x1d, 0xf2, 0x4f, 0x99,
+	0xd8, 0xd8, 0xe2, 0x94, 0x39, 0xff, 0x28, 0x10, 0xdd, 0x84, 0x75, 0x0f, 0x8f, 0x4c, 0xdb, 0xb1,
+	0x9d, 0x7e, 0x5d, 0x58, 0x54, 0xc8, 0x30, 0xbb, 0x81, 0x5e, 0x87, 0x02, 0x63, 0xd9, 0x74, 0xab,
+	0x96, 0xe5, 0x61, 0xdf, 0x2f, 0x2d, 0x33, 0xd4, 0x18, 0x54, 0xff, 0x6b, 0x0a, 0x72, 0x81, 0x35,
+	0x10, 0x82, 0x25, 0xc7, 0x1c, 0x61, 0x21, 0x34, 0xfb, 0x8d, 0x4a, 0xb0, 0x62, 0x0a, 0x12, 0x5c,
+	0x6a, 0xb9, 0x44, 0xdb, 0x80, 0x98, 0xf2, 0x8f, 0x13, 0x84, 0x4f, 0xd8, 0xa1, 0x1a, 0xf8, 0x78,
+	0xd8, 0xa3, 0xc6, 0xb4, 0x9d, 0x7e, 0xc7, 0x3d, 0xc6, 0x8e, 0xb4, 0xe2, 0xec, 0x06, 0x75, 0xa1,
+	0x3b, 0xc6, 0x9e, 0x49, 0x5c, 0x2f, 0xaa, 0x42, 0x1c, 0x4c, 0xed, 0xe7, 0xe1, 0xa7, 0xa6, 0x67,
+	0x49, 0xbc, 0x0c, 0xb7, 0x5f, 0x04, 0x88, 0x2e, 0x43, 0xce, 0x0e, 0x8c, 0xb1, 0xc2, 0x30, 0x42,
+	0x00, 0x0d, 0x83, 0x91, 0x39, 0xec, 0xb9, 0xde, 0x08, 0x07, 0x64, 0xb2, 0xd7, 0xb4, 0xad, 0xac,
+	0x31, 0x03, 0x57, 0x82, 0x2e, 0xa7, 0x06, 0x9d, 0xfe, 0x63, 0xd8, 0xd8, 0xc3, 0x24, 0x8c, 0x2d,
+	0x03, 0x7f, 0x36, 0xc1, 0x3e, 0x99, 0x1b, 0xa4, 0x9b, 0x90, 0x71, 0x7b, 0x3d, 0x1f, 0x13, 0x66,
+	0xd8, 0xbc, 0x21, 0x56, 0x34, 0x4a, 0x86, 0xf6, 0xc8, 0x26, 0xcc, 0x94, 0x79, 0x83, 0x2f, 0xf4,
+	0x3d, 0xb8, 0xa8, 0x52, 0xdf, 0x9d, 0xb6, 0xcc, 0x11, 0x96, 0x2c, 0x92, 0x1c, 0x17, 0xb2, 0x4d,
+	0x45, 0xc4, 0xfc, 0x87, 0x06, 0x97, 0xf7, 0x30, 0xe1, 0x81, 0xea, 0xef, 0x4e, 0x03, 0x92, 0xa7,
+	0x20, 0xa6, 0xe8, 0x90, 0x4e, 0xd6, 0x61, 0x49, 0xd1, 0x01, 0xdd, 0x80, 0x8c, 0xef, 0x7a, 0x64,
+	0x77, 0xca, 0x5c, 0x59, 0xd8, 0x41, 0xdb, 0x34, 0xb3, 0x70, 0x49, 0xda, 0xae, 0x47, 0x3e, 0xc2,
+	0x53, 0x43, 0x60, 0xd0, 0xc3, 0x66, 0x61, 0xbf, 0x8b, 0x1d, 0xcb, 0x76, 0xfa, 0xcc, 0xa5, 0x59,
+	0x43, 0x81, 0x50, 0xce, 0xdd, 0x89, 0xe7, 0xbb, 0x9e, 0x70, 0xa6, 0x58, 0xe9, 0x7f, 0xd6, 0x60,
+	0x3d, 0x54, 0xef, 0x7b, 0xf5, 0x81, 0x22, 0xff, 0xd2, 0x0b, 0xca, 0xbf, 0xfc, 0x1c, 0xf9, 0x33,
+	0x11, 0xf9, 0xff, 0x1f, 0xd6, 0xf6, 0x30, 0xa1, 0x27, 0xc6, 0x5b, 0x24, 0x7c, 0x90, 0x64, 0x94,
+	0x74, 0xe2, 0xe9, 0x87, 0x90, 0xa7, 0x5f, 0x5b, 0x75, 0x3c, 0xc4, 0xfd, 0x79, 0xa7, 0xfa, 0x25,
+	0x32, 0x91, 0xfe, 0x4d, 0x8a, 0x73, 0xf0, 0x0c, 0xec, 0x8f, 0x5d, 0xc7, 0xc7, 0x73, 0xb2, 0xdd,
+	0x6b, 0xb0, 0x72, 0xc4, 0xbd, 0x50, 0x4a, 0x5d, 0x4b, 0x6f, 0xad, 0xee, 0xac, 0x2a, 0xe6, 0x32,
+	0xe4, 0x5e, 0x2c, 0xab, 0xa6, 0xe3, 0x59, 0x75, 0x4e, 0x9a, 0x59, 0x9a, 0x9b, 0x66, 0x6e, 0x43,
+	0xce, 0x12, 0xaa, 0xd3, 0x94, 0x41, 0x19, 0x73, 0x3f, 0x45, 0xac, 0x62, 0x84, 0x48, 0x09, 0xc9,
+	0x32, 0x93, 0x94, 0x2c, 0x15, 0x3f, 0xac, 0x44, 0x4e, 0xd4, 0x36, 0x3b, 0xf8, 0x6d, 0x7a, 0x0d,
+	0xfa, 0xc4, 0xee, 0x2e, 0x0a, 0x3a, 0xfd, 0x6d, 0xc8, 0x77, 0xdc, 0x31, 0x33, 0x61, 0x7b, 0x60,
+	0x7a, 0x18, 0x15, 0x21, 0x4d, 0xdc, 0x31, 0xc3, 0xca, 0x1b, 0xf4, 0x27, 0xb5, 0xa8, 0x4f, 0xb7,
+	0x98, 0x7f, 0x34, 0x83, 0x2f, 0xf4, 0x7d, 0x28, 0x48, 0xf9, 0xd9, 0xd7, 0x7e, 0xa2, 0x6f, 0x75,
+	0x38, 0x3b, 0x71, 0xec, 0xcf, 0x26, 0x02, 0x47, 0x44, 0x76, 0x04, 0xa6, 0xff, 0x29, 0x05, 0x10,
+	0x0a, 0x3c, 0x37, 0xc2, 0x6e, 0xc3, 0x39, 0xc7, 0x3c, 0x36, 0x47, 0x2e, 0x71, 0x6b, 0x2e, 0xee,
+	0xf5, 0xec, 0xae, 0x8d, 0x1d, 0x79, 0x56, 0x92, 0xb6, 0x68, 0x9a, 0x65, 0x56, 0xdb, 0xb3, 0x1d,
+	0x9b, 0x39, 0x53, 0x33, 0x42, 0x00, 0x7a, 0x17, 0x0a, 0x44, 0xd5, 0x9c, 0xfa, 0x31, 0x74, 0x50,
+	0xc4, 0x28, 0x46, 0x0c, 0x13, 0xbd, 0x07, 0x05, 0x2b, 0xa2, 0xbc, 0x70, 0xee, 0x39, 0xf6, 0x6d,
+	0xd4, 0x2e, 0x46, 0x0c, 0x95, 0xe6, 0x77, 0xe5, 0x8a, 0x61, 0x14, 0x99, 0x93, 0x35, 0x63, 0x06,
+	0x8e, 0xae, 0xc1, 0x6a, 0x10, 0x7e, 0x1e, 0xbf, 0x2b, 0xf2, 0x86, 0x0a, 0xd2, 0xbf, 0xd5, 0xe0,
+	0xdc, 0x3e, 0x36, 0x87, 0x64, 0x50, 0x1b, 0xe0, 0xee, 0x71, 0x70, 0x0e, 0xde, 0x86, 0x8c, 0x4f,
+	0x4c, 0x32, 0xf1, 0x99, 0x19, 0x0b, 0x3b, 0x57, 0x99, 0x68, 0x09, 0x98, 0xdb, 0x6d, 0x86, 0x66,
+	0x08, 0x74, 0xf4, 0x26, 0x64, 0x7b, 0xa6, 0x3d, 0x9c, 0x78, 0x58, 0x9e, 0x95, 0x0b, 0xec, 0xd3,
+	0x9a, 0xeb, 0xf8, 0xb6, 0x4f, 0xb0, 0xd3, 0x9d, 0xde, 0xe3, 0xfb, 0x46, 0x80, 0xa8, 0x7f, 0x08,
+	0x19, 0x4e, 0x06, 0x9d, 0x85, 0x6c, 0xbb, 0x53, 0x35, 0x3a, 0xcd, 0xd6, 0x5e, 0xf1, 0x0c, 0x02,
+	0xc8, 0x54, 0x6b, 0x9d, 0xe6, 0xc7, 0x8d, 0xa2, 0x46, 0x77, 0x9a, 0x2d, 0xb1, 0x4a, 0xe9, 0x4b,
+	0xd9, 0x74, 0x31, 0x7d, 0x23, 0x5b, 0x6f, 0xec, 0x19, 0xd5, 0x7a, 0xa3, 0xae, 0xff, 0x3e, 0x05,
+	0x68, 0x96, 0xc5, 0xf3, 0xf2, 0x4d, 0xd7, 0x9c, 0xf8, 0x58, 0x26, 0x0d, 0xb6, 0x40, 0x65, 0xc8,
+	0x9a, 0x84, 0xd0, 0x22, 0xce, 0x17, 0xd9, 0x32, 0x58, 0xa3, 0x0f, 0xe0, 0x6c, 0xcf, 0xf6, 0x7c,
+	0x22, 0x28, 0xb3, 0x53, 0xbb, 0xba, 0x53, 0xde, 0xe6, 0x55, 0xde, 0xb6, 0xac, 0xf2, 0xb6, 0x3b,
+	0xb2, 0xca, 0x33, 0x22, 0xf8, 0xe8, 0x7d, 0x58, 0x1d, 0x9a, 0xe1, 0xe7, 0xcb, 0x0b, 0x3f, 0x57,
+	0xd1, 0xd1, 0x3b, 0x90, 0x73, 0xf0, 0xe7, 0xc4, 0xc0, 0xc4, 0x9b, 0x96, 0x32, 0x0b, 0xbf, 0x0d,
+	0x91, 0xf5, 0x7f, 0x2e, 0x01, 0xb4, 0xa7, 0x4e, 0x57, 0xd8, 0xf7, 0xd4, 0x7e, 0xbd, 0x0c, 0x39,
+	0x62, 0x8f, 0xf7, 0xd5, 0x9b, 0x33, 0x04, 0xa0, 0x3b, 0xb0, 0x42, 0xec, 0x31, 0x15, 0xa0, 0x94,
+	0x5e, 0x28, 0x9d, 0x44, 0xa5, 0xa1, 0x4c, 0x95, 0xa4, 0xe2, 0x61, 0x4b, 0x90, 0xe6, 0xd9, 0x70,
+	0x06, 0x8e, 0x76, 0xa1, 0x10, 0xc2, 0x18, 0xa3, 0xc5, 0x26, 0x8c, 0x7d, 0x41, 0xf3, 0xf3, 0xd0,
+	0xec, 0x73, 0x82, 0x3c, 0x33, 0x2e, 0x19, 0x0a, 0x04, 0xbd, 0x47, 0x7d, 0x14, 0x96, 0xa4, 0x2b,
+	0x8c, 0xc1, 0xc5, 0x19, 0x06, 0x12, 0xc1, 0x50, 0xb1, 0x69, 0xea, 0x1d, 0xf3, 0x0b, 0x51, 0x32,
+	0xc8, 0x32, 0x06, 0x31, 0x68, 0xe4, 0x80, 0xe4, 0x4e, 0x78, 0x40, 0x68, 0x64, 0x62, 0xc7, 0x1a,
+	0xbb, 0xb6, 0x43, 0x4a, 0xc0, 0x2c, 0x14, 0xac, 0xe9, 0xad, 0xd2, 0x35, 0x49, 0x77, 0xf0, 0x68,
+	0xdc, 0x26, 0xa6, 0x47, 0x84, 0x1d, 0x57, 0x19, 0x56, 0xc2, 0x0e, 0xcd, 0x84, 0x02, 0xda, 0x31,
+	0xbd, 0x3e, 0x96, 0x1f, 0x9c, 0x65, 0x1f, 0x24, 0x6d, 0xd1, 0x02, 0x56, 0x80, 0x1f, 0x7a, 0x6e,
+	0x9f, 0x5d, 0x2b, 0x79, 0x96, 0x71, 0xe2, 0x60, 0xfd, 0x47, 0xb0, 0xae, 0x14, 0x61, 0x22, 0x97,
+	0x6c, 0x03, 0x74, 0xc3, 0xee, 0x45, 0x63, 0x3a, 0x17, 0xb8, 0xce, 0x01, 0xae, 0x82, 0x31, 0xb7,
+	0xdc, 0x73, 0xa1, 0x20, 0x6e, 0x5c, 0x49, 0x59, 0xb9, 0x97, 0xb5, 0xe7, 0xdf, 0xcb, 0xf4, 0x40,
+	0xd4, 0x78, 0x91, 0xc2, 0x89, 0x2a, 0x10, 0x85, 0x61, 0x3a, 0xe1, 0x36, 0x34, 0xb0, 0x3f, 0x19,
+	0x12, 0xc3, 0x75, 0xc9, 0xa2, 0xdb, 0xf0, 0x0b, 0x80, 0x10, 0x79, 0x1e, 0x16, 0xbd, 0xe8, 0x3c,
+	0xd7, 0x95, 0xca, 0xb1, 0xdf, 0xb4, 0x5c, 0x09, 0x2e, 0x71, 0xfa, 0xb1, 0x2c, 0x57, 0x22, 0x40,
+	0x79, 0x23, 0x71, 0x0c, 0x7e, 0x50, 0x42, 0x80, 0x7e, 0x0f, 0x4a, 0x6a, 0x59, 0xfd, 0xd0, 0x73,
+	0xdd, 0xde, 0x69, 0xaa, 0xea, 0x1f, 0xc2, 0xf9, 0xa0, 0xea, 0x3c, 0x2d, 0x11, 0x9a, 0x60, 0x6d,
+	0xc7, 0xc2, 0x9f, 0xcb, 0xaa, 0x93, 0x2d, 0xf4, 0x37, 0x21, 0xc7, 0x28, 0xb6, 0x09, 0x1e, 0x53,
+	0x72, 0x03, 0xd3, 0x1f, 0x48, 0x72, 0xf4, 0x37, 0x85, 0x0d, 0x71, 0x8f, 0x13, 0xcb, 0x1a, 0xec,
+	0xb7, 0xee, 0xc3, 0xea, 0x03, 0xec, 0x1d, 0x0f, 0xb9, 0x46, 0x81, 0xf9, 0x34, 0xc5, 0x7c, 0xec,
+	0x33, 0xb3, 0x27, 0x4d, 0x4a, 0x7f, 0xa3, 0xeb, 0xb0, 0xec, 0x13, 0x3c, 0xa6, 0x99, 0x3c, 0x0c,
+	0xb8, 0x80, 0xbb, 0xc1, 0x37, 0x15, 0xf9, 0x97, 0x22, 0x46, 0xa8, 0xcb, 0x58, 0x6b, 0xe3, 0x21,
+	0xee, 0x12, 0xd7, 0x9b, 0x53, 0x19, 0x5e, 0x86, 0x5c, 0x10, 0xb9, 0x32, 0x2d, 0x06, 0x00, 0xbd,
+	0x09, 0x59, 0x7a, 0xcf, 0x3e, 0x70, 0x9f, 0x60, 0x74, 0x4b, 0x8d, 0x55, 0x2d, 0xb8, 0xed, 0xa3,
+	0x5c, 0xc2, 0x98, 0x2d, 0x40, 0x8a, 0xb8, 0x82, 0x62, 0x8a, 0xb8, 0xfa, 0x13, 0x28, 0xc8, 0x54,
+	0x53, 0x1b, 0x98, 0x4e, 0xff, 0x85, 0x09, 0xbe, 0x05, 0x59, 0x39, 0xbe, 0x28, 0xa5, 0x16, 0x65,
+	0xb6, 0x00, 0x55, 0xff, 0x4e, 0x83, 0xb5, 0xb6, 0x3d, 0x9a, 0x0c, 0x4d, 0x82, 0xad, 0xe7, 0x8e,
+	0x04, 0x9e, 0x6b, 0x0a, 0x6a, 0x68, 0x73, 0xe4, 0x4e, 0x9c, 0xe0, 0x8c, 0xf1, 0x15, 0xbd, 0xd9,
+	0x7c, 0x9a, 0x9c, 0x58, 0x4a, 0x5f, 0x7c, 0xa9, 0x86, 0xc8, 0x11, 0x85, 0x96, 0x4f, 0xac, 0x10,
+	0x15, 0xde, 0xc2, 0x5d, 0x73, 0x2a, 0x1a, 0x31, 0xbe, 0xd0, 0xbf, 0x4a, 0x87, 0x6a, 0x2e, 0x6a,
+	0x56, 0xfe, 0x97, 0x76, 0xe9, 0x23, 0xf7, 0x09, 0xde, 0x8d, 0xf4, 0x04, 0x89, 0xe6, 0x8f, 0x62,
+	0xa2, 0x37, 0x20, 0x47, 0x97, 0xb2, 0x41, 0xa0, 0x9f, 0xe5, 0x83, 0x8a, 0x9e, 0x86, 0x89, 0x11,
+	0xee, 0xa3, 0xff, 0x83, 0xb5, 0x2e, 0x73, 0xb5, 0xd4, 0x42, 0xd6, 0x98, 0xa2, 0x4e, 0x8c, 0x84,
+	0x83, 0x11, 0xc7, 0x45, 0x77, 0x00, 0x4c, 0xcb, 0x92, 0x32, 0xf2, 0x0a, 0x73, 0x83, 0x7d, 0x19,
+	0xf3, 0xa7, 0xa1, 0xe0, 0xd1, 0x4c, 0x44, 0xdd, 0xd9, 0x19, 0x78, 0xd8, 0x1f, 0xb8, 0x43, 0x4b,
+	0x8e, 0x20, 0x22, 0x40, 0x7a, 0xd9, 0xf9, 0x5d, 0xd7, 0x53, 0xd0, 0x78, 0x1f, 0x11, 0x83, 0xa2,
+	0x1d, 0xd8, 0x50, 0xe7, 0x21, 0x01, 0x76, 0x96, 0x61, 0x27, 0xee, 0xe9, 0xbf, 0xd6, 0xc2, 0xde,
+	0x40, 0x84, 0xfa, 0x9c, 0x69, 0x8e, 0x3b, 0xb4, 0x0c, 0xd3, 0x39, 0x16, 0x45, 0xbc, 0x5c, 0xd2,
+	0x1d, 0x07, 0x3f, 0x65, 0x3b, 0x3c, 0xfb, 0xc8, 0x25, 0xbd, 0x46, 0xdd, 0x61, 0xa4, 0xed, 0x0a,
+	0xd6, 0x74, 0xcf, 0xc1, 0x4f, 0xf9, 0x1e, 0x1f, 0xcf, 0x04, 0x6b, 0xfd, 0x6b, 0x0d, 0x8a, 0x61,
+	0x74, 0x9c, 0xf2, 0x5a, 0xbb, 0x05, 0x2b, 0xdc, 0x45, 0xd1, 0x80, 0x89, 0xaa, 0x6a, 0x48, 0x9c,
+	0xb9, 0x97, 0xd2, 0x2e, 0xbb, 0x94, 0x1a, 0x9f, 0x77, 0x87, 0x13, 0x9f, 0xfa, 0x79, 0x51, 0xb4,
+	0x4a, 0xdb, 0xa5, 0x42, 0xdb, 0xe9, 0x2e, 0xac, 0x33, 0x02, 0x16, 0xb6, 0x02, 0x59, 0xd1, 0x4d,
+	0xf5, 0xfc, 0xf2, 0x8c, 0x12, 0x57, 0x27, 0x44, 0x40, 0x37, 0x21, 0xe3, 0x61, 0xd3, 0x17, 0xc9,
+	0xa4, 0x20, 0x22, 0x2b, 0x10, 0xcb, 0x60, 0x7b, 0x86, 0xc0, 0xd1, 0xbf, 0xd2, 0xa0, 0x20, 0x39,
+	0xbe, 0x44, 0x12, 0x09, 0x7a, 0xfd, 0xb4, 0xda, 0xeb, 0x87, 0xa2, 0x2c, 0x9d, 0x40, 0x94, 0xbf,
+	0x6b, 0xb0, 0xae, 0xec, 0x09, 0x67, 0xde, 0x4d, 0x70, 0xe6, 0x66, 0x48, 0x47, 0x35, 0x54, 0xdc,
+	0xa9, 0x47, 0x09, 0x59, 0x20, 0xaa, 0x6b, 0x98, 0x84, 0x37, 0x21, 0x33, 0xf6, 0x26, 0x0e, 0xb6,
+	0x98, 0x06, 0x59, 0x43, 0xac, 0xa8, 0xed, 0x83, 0xe1, 0x9c, 0x38, 0xe4, 0x33, 0xb6, 0x0f, 0x10,
+	0x14, 0x57, 0x2f, 0x47, 0x42, 0x63, 0x9f, 0x55, 0x00, 0xbb, 0x43, 0xb7, 0x7b, 0xfc, 0xd0, 0x73,
+	0xad, 0x49, 0x17, 0x7b, 0x0b, 0xc3, 0x63, 0x03, 0x96, 0xf1, 0xd8, 0xed, 0x0e, 0xc4, 0x54, 0x99,
+	0x2f, 0xf4, 0xbf, 0x68, 0xb0, 0x19, 0xa7, 0x23, 0x2c, 0xf5, 0x42, 0x84, 0xd0, 0x07, 0x80, 0xba,
+	0xf4, 0x33, 0xc7, 0x9f, 0xf8, 0xf5, 0x60, 0x96, 0x91, 0x4e, 0xd4, 0x30, 0x01, 0x13, 0xdd, 0x85,
+	0xc2, 0x51, 0x44, 0x8e, 0x39, 0xd6, 0x89, 0x61, 0xe9, 0x36, 0xe4, 0x1f, 0x7a, 0xee, 0x11, 0x4b,
+	0x85, 0xf7, 0x6d, 0x5f, 0x11, 0x4f, 0x53, 0xc5, 0xbb, 0x0e, 0x79, 0xdb, 0x21, 0xd8, 0xf1, 0x6d,
+	0x32, 0x35, 0x64, 0xc8, 0xe5, 0x8d, 0x28, 0x90, 0x06, 0xa5, 0x15, 0x91, 0x3d, 0xa7, 0xcc, 0x5c,
+	0xf4, 0x5d, 0x40, 0xb4, 0x4a, 0x34, 0x1d, 0x9a, 0xc6, 0x4e, 0x69, 0xef, 0x6f, 0x35, 0x58, 0x11,
+	0x14, 0x5e, 0xf0, 0x1c, 0x96, 0x60, 0xc5, 0x33, 0x9f, 0xaa, 0x69, 0x50, 0x2c, 0x59, 0xa1, 0x14,
+	0xe6, 0x40, 0xf6, 0x9b, 0xea, 0x6b, 0x5a, 0x3f, 0x9d, 0xf8, 0xf4, 0x25, 0x80, 0x66, 0x6a, 0x91,
+	0x05, 0xa3, 0x40, 0xaa, 0xef, 0x58, 0x1a, 0x4f, 0xcc, 0xfb, 0x42, 0x00, 0xeb, 0x45, 0x44, 0x78,
+	0x8b, 0x3b, 0x34, 0x58, 0xeb, 0xdf, 0x68, 0x50, 0x0c, 0x2d, 0xb1, 0x20, 0x62, 0xde, 0x81, 0xfc,
+	0x58, 0xf5, 0x91, 0x28, 0x4b, 0x90, 0xac, 0xd4, 0xc2, 0x1d, 0x23, 0x8a, 0x88, 0xb6, 0x20, 0xeb,
+	0x09, 0x2e, 0x22, 0x96, 0xce, 0xb2, 0x8f, 0x04, 0x6b, 0x23, 0xd8, 0xd5, 0x3f, 0x81, 0x8d, 0x36,
+	0xf1, 0xb0, 0x39, 0xe2, 0x85, 0x79, 0xe0, 0x9e, 0x2b, 0x00, 0x3d, 0xcf, 0x1d, 0xed, 0xab, 0x72,
+	0x29, 0x10, 0x3a, 0x79, 0x22, 0xee, 0x38, 0x8c, 0x58, 0x31, 0x79, 0x52, 0x61, 0xfa, 0xd7, 0x29,
+	0xc8, 0x73, 0xb2, 0xed, 0xc9, 0x68, 0x64, 0x7a, 0xd3, 0xb9, 0x9a, 0xde, 0x85, 0xec, 0xc8, 0x76,
+	0x78, 0x8d, 0x93, 0x5a, 0x58, 0xe3, 0x04, 0xb8, 0x68, 0x27, 0x26, 0x45, 0xf2, 0xb9, 0x89, 0xe0,
+	0x2c, 0x7a, 0xda, 0x49, 0x7c, 0x26, 0x5a, 0x9e, 0xf3, 0x4c, 0x94, 0xf0, 0xf8, 0x94, 0x49, 0x7c,
+	0x7c, 0xd2, 0x0f, 0x21, 0xb7, 0x8f, 0x4d, 0x8f, 0x1c, 0x61, 0x93, 0x19, 0x8f, 0xde, 0x94, 0x3e,
+	0x89, 0x98, 0x37, 0x02, 0x43, 0xdb, 0xb0, 0x44, 0x4e, 0x66, 0x0e, 0x86, 0xa7, 0x13, 0x58, 0xe5,
+	0xb6, 0x6e, 0x3c, 0xc1, 0x0e, 0xe1, 0x39, 0x9f, 0x2e, 0x4b, 0x9a, 0x12, 0x34, 0x11, 0x6f, 0xec,
+	0x9f, 0x31, 0x04, 0x0e, 0xda, 0x86, 0xdc, 0x40, 0x4a, 0x27, 0x38, 0x16, 0xe4, 0xe0, 0x83, 0x43,
+	0xf7, 0xcf, 0x18, 0x21, 0xca, 0xee, 0x0a, 0x2c, 0x63, 0xca, 0x46, 0xdf, 0x87, 0xcd, 0x3d, 0xd9,
+	0x06, 0xef, 0x4e, 0xa9, 0x4c, 0x32, 0x80, 0xa4, 0xfc, 0xda, 0x09, 0xe5, 0xff, 0x14, 0x0a, 0x9c,
+	0xcc, 0xc2, 0x63, 0x71, 0xca, 0x60, 0xd1, 0x87, 0x4c, 0x56, 0x6e, 0x82, 0x97, 0x92, 0xf5, 0x44,
+	0xc1, 0xff, 0x6f, 0x0d, 0xca, 0x6a, 0xbb, 0xb9, 0x6f, 0xfb, 0xc4, 0xf5, 0xa6, 0xcf, 0xeb, 0x15,
+	0xaf, 0xc1, 0xaa, 0xaf, 0x4c, 0x28, 0xf8, 0xed, 0xae, 0x82, 0x68, 0xe2, 0xc1, 0x8e, 0x9c, 0x04,
+	0xf1, 0x3b, 0x3e, 0x04, 0xbc, 0x44, 0xab, 0x70, 0x07, 0x56, 0xb0, 0x73, 0xd2, 0xa9, 0x91, 0x44,
+	0xa5, 0x3a, 0xd0, 0x26, 0x91, 0x85, 0x7c, 0xde, 0x60, 0xbf, 0xf5, 0xdf, 0xa5, 0xe0, 0x7c, 0x5c,
+	0xe7, 0x87, 0x6c, 0x0c, 0xf3, 0x7d, 0x9f, 0xfd, 0xa4, 0xf4, 0x4d, 0x67, 0xe9, 0x4a, 0xda, 0xe6,
+	0x8b, 0xe4, 0xd7, 0xc8, 0xe5, 0x79, 0xaf, 0x91, 0x57, 0x00, 0x58, 0xa9, 0x55, 0x63, 0xcd, 0x18,
+	0xd7, 0x4d, 0x81, 0x50, 0x1f, 0x7c, 0x36, 0x31, 0x87, 0x76, 0xcf, 0xc6, 0xbc, 0xaa, 0xcf, 0x1a,
+	0x21, 0x40, 0x29, 0x60, 0xb2, 0x6a, 0x01, 0xa3, 0x1f, 0x41, 0x69, 0x36, 0x14, 0x44, 0xa0, 0x27,
+	0xc5, 0xc2, 0x0e, 0x64, 0xd8, 0xf4, 0x4a, 0x96, 0x4d, 0xe5, 0x68, 0x4e, 0x53, 0x2d, 0x6b, 0x08,
+	0xcc, 0x1b, 0x1e, 0xe4, 0x23, 0x0f, 0x54, 0x28, 0x0f, 0xb9, 0x5a, 0xb5, 0x75, 0xd0, 0x6a, 0xd6,
+	0xaa, 0xf7, 0xc5, 0xb4, 0xf8, 0xc1, 0xc1, 0xa3, 0x56, 0xa7, 0xa8, 0xa1, 0x73, 0xb0, 0xf6, 0xb8,
+	0xd1, 0xdc, 0xdb, 0xef, 0x34, 0xea, 0x87, 0x02, 0x98, 0x42, 0x9b, 0x80, 0x8c, 0xc6, 0x83, 0x6a,
+	0xb3, 0xd5, 0x6c, 0xed, 0x1d, 0xd6, 0x1f, 0x19, 0xd5, 0x4e, 0xf3, 0xa0, 0x55, 0x4c, 0xa3, 0x02,
+	0x00, 0x1b, 0x3a, 0x1f, 0x76, 0x9a, 0x0f, 0x1a, 0xc5, 0x25, 0x94, 0x83, 0xe5, 0x8f, 0x0f, 0x3a,
+	0x0d, 0xa3, 0xb8, 0x7c, 0xe3, 0x5f, 0x1a, 0xac, 0xc5, 0x2a, 0x49, 0x84, 0xa0, 0xf0, 0xa8, 0xf5,
+	0x51, 0xeb, 0xe0, 0x71, 0xeb, 0xd0, 0x68, 0x54, 0xdb, 0x07, 0xad, 0xe2, 0x19, 0x4a, 0xfa, 0x41,
+	0xb5, 0xd5, 0xbc, 0xd7, 0x6c, 0xd4, 0x0f, 0x6b, 0xd5, 0x56, 0xbd, 0x59, 0xaf, 0x76, 0xe8, 0xd4,
+	0x7a, 0x13, 0xd0, 0xbd, 0xe6, 0xfd, 0x4e, 0xc3, 0x88, 0xc0, 0x53, 0x68, 0x1d, 0xf2, 0x01, 0x9c,
+	0xf2, 0x2a, 0xa6, 0xd1, 0x05, 0x38, 0xf7, 0x49, 0xc3, 0x38, 0x08, 0xd1, 0xf8, 0xc6, 0x12, 0x2a,
+	0xc3, 0xa6, 0xe4, 0x17, 0xdb, 0x5b, 0x46, 0x97, 0xe0, 0x42, 0xe3, 0x07, 0xb5, 0xfb, 0x8f, 0xea,
+	0x8d, 0x7a, 0x7c, 0x33, 0x43, 0x29, 0xde, 0x3f, 0x78, 0x7c, 0xd8, 0xae, 0x1d, 0x18, 0x0d, 0x85,
+	0xfb, 0x0a, 0xba, 0x02, 0x65, 0xb6, 0xd1, 0xb8, 0x7f, 0xef, 0xb0, 0xdd, 0xa9, 0x7e, 0x44, 0xed,
+	0x11, 0xee, 0x67, 0x77, 0x7e, 0xbb, 0x0e, 0x50, 0x7d, 0xd8, 0x6c, 0x63, 0xef, 0x89, 0xdd, 0xc5,
+	0xa8, 0x06, 0x2b, 0x7d, 0x4c, 0xf8, 0xbf, 0x29, 0xcc, 0xc4, 0x6e, 0x83, 0xfe, 0xcf, 0x43, 0x59,
+	0xdc, 0x49, 0xf2, 0xdf, 0x19, 0xf4, 0xe2, 0xcf, 0xff, 0xf6, 0xdd, 0x6f, 0x52, 0x80, 0xb2, 0x95,
+	0x27, 0xff, 0x53, 0x19, 0xd1, 0x2f, 0x8f, 0x21, 0xdf, 0x57, 0xdf, 0x94, 0xd1, 0x45, 0xf6, 0x49,
+	0xd2, 0x3b, 0x73, 0x79, 0x33, 0x76, 0xc3, 0x89, 0x48, 0xd2, 0xff, 0x9b, 0x51, 0x7d, 0x15, 0x5d,
+	0xa5, 0x54, 0xf9, 0xf9, 0xf2, 0x2b, 0xcf, 0xf8, 0x8f, 0x2f, 0x2b, 0x4a, 0x59, 0x4e, 0x00, 0xf5,
+	0x67, 0x9e, 0x98, 0xd1, 0x95, 0x19, 0x8e, 0x91, 0xb7, 0xe7, 0x72, 0xec, 0x62, 0xd5, 0xb7, 0x19,
+	0xbb, 0x2d, 0xf4, 0xfa, 0x02, 0x76, 0x95, 0x67, 0x34, 0xa6, 0xbf, 0x44, 0xbf, 0xd2, 0xe0, 0x7c,
+	0x3f, 0xe9, 0x3d, 0x1a, 0xbd, 0x2a, 0x39, 0xcf, 0x7d, 0xab, 0x2e, 0xab, 0xe3, 0x83, 0x40, 0xe1,
+	0xbb, 0x4c, 0x82, 0xdb, 0x68, 0xfb, 0x64, 0x12, 0x54, 0x64, 0x9f, 0x71, 0x08, 0x10, 0x0a, 0x82,
+	0x36, 0x63, 0xdc, 0x9f, 0xcb, 0xf2, 0x3a, 0x63, 0x79, 0x05, 0x5d, 0x4e, 0x64, 0x29, 0x19, 0x98,
+	0x90, 0xed, 0x8b, 0xb7, 0x5d, 0xb4, 0x21, 0xc9, 0xab, 0x4f, 0xbd, 0xe5, 0xf0, 0xa5, 0x32, 0x78,
+	0x5d, 0xd5, 0xdf, 0x60, 0xb4, 0x5f, 0x43, 0xff, 0x95, 0x48, 0x9b, 0x25, 0x21, 0xbf, 0xf2, 0x8c,
+	0xfd, 0xfd, 0x12, 0x59, 0x2c, 0x60, 0x94, 0xa7, 0xbd, 0x20, 0x60, 0x66, 0xde, 0x27, 0xcb, 0x6b,
+	0x6c, 0x2b, 0x84, 0x2f, 0x88, 0x14, 0x3f, 0x24, 0xfa, 0x10, 0xb2, 0xb6, 0xcf, 0xdf, 0x3f, 0xe6,
+	0x06, 0x77, 0x69, 0xde, 0x23, 0x89, 0x8e, 0x18, 0x9b, 0xb3, 0x08, 0x38, 0x1b, 0x46, 0x65, 0x1f,
+	0x72, 0x42, 0xee, 0x89, 0x3f, 0x97, 0xa4, 0x10, 0x38, 0x78, 0x98, 0x89, 0x52, 0x12, 0x6f, 0x2e,
+	0xfc, 0xc8, 0x84, 0xad, 0x7e, 0x68, 0x81, 0x99, 0xf6, 0xbf, 0xbc, 0x19, 0x6f, 0x7a, 0x4f, 0x74,
+	0x64, 0x70, 0x48, 0xdb, 0x84, 0xac, 0x2f, 0x46, 0x1c, 0x28, 0x3a, 0x26, 0x92, 0x2c, 0xce, 0xc7,
+	0xa0, 0x82, 0xc3, 0x16, 0xe3, 0xa0, 0xeb, 0xaf, 0x24, 0x9b, 0x5a, 0xa0, 0xbf, 0xab, 0xdd, 0x40,
+	0x3f, 0x61, 0xfa, 0x28, 0x23, 0xf2, 0x40, 0x9f, 0x99, 0x19, 0xbb, 0x30, 0x50, 0x08, 0xd7, 0x5f,
+	0x65, 0x6c, 0x2e, 0xa1, 0x8b, 0x89, 0x6c, 0xd8, 0x18, 0xf8, 0x67, 0xb0, 0xde, 0x8f, 0x4f, 0xc0,
+	0xd1, 0x2b, 0x33, 0x87, 0x5e, 0x1d, 0x6a, 0x97, 0x8b, 0x6c, 0x5b, 0x19, 0x30, 0xeb, 0x77, 0x18,
+	0xa3, 0x6d, 0x74, 0xf3, 0x84, 0x67, 0x6e, 0xcc, 0xd8, 0xfc, 0x42, 0x83, 0x42, 0x3f, 0x32, 0x36,
+	0x47, 0xe5, 0xe8, 0xb1, 0x5b, 0xc0, 0xb6, 0xce, 0xd8, 0x7e, 0x80, 0xde, 0x7f, 0xb1, 0xa3, 0x5e,
+	0x79, 0xc6, 0x46, 0xeb, 0x52, 0x8c, 0x5f, 0x6a, 0xcc, 0x06, 0xd1, 0xde, 0x3d, 0xb4, 0x41, 0xe2,
+	0x6c, 0xa0, 0x7c, 0x89, 0xe7, 0x81, 0xc4, 0x7e, 0x5f, 0x7f, 0x8b, 0xc9, 0x55, 0x41, 0xb7, 0x92,
+	0x03, 0x88, 0xb6, 0xaf, 0x7e, 0xe5, 0x19, 0xfb, 0xcb, 0x84, 0x10, 0x2c, 0x7d, 0x58, 0xed, 0x87,
+	0x5d, 0x31, 0xba, 0x10, 0x78, 0x3a, 0xda, 0x27, 0x8b, 0xa0, 0x8a, 0xf7, 0x8c, 0x0b, 0x9c, 0x10,
+	0xe3, 0x2a, 0xbb, 0x3d, 0xf4, 0x21, 0x14, 0xc7, 0x13, 0x12, 0x6d, 0xfc, 0x13, 0xda, 0xc9, 0xf2,
+	0x9c, 0x53, 0xa9, 0x9f, 0x41, 0x9f, 0x40, 0xde, 0x57, 0xfb, 0x45, 0x11, 0xa2, 0x49, 0x3d, 0xa4,
+	0xf0, 0xa1, 0xd2, 0x95, 0xe8, 0x65, 0x26, 0xf5, 0x06, 0x42, 0x54, 0x6a, 0xde, 0x7b, 0xf8, 0x15,
+	0x4e, 0xef, 0xb6, 0x86, 0x3e, 0x85, 0xb5, 0x7e, 0xb4, 0x99, 0x40, 0x97, 0xa4, 0x59, 0x12, 0x5a,
+	0x0c, 0x91, 0x9e, 0xa3, 0x5d, 0x83, 0x7e, 0x89, 0xb1, 0x38, 0x8f, 0xce, 0xa9, 0x86, 0x39, 0x9a,
+	0xde, 0x62, 0x85, 0xfb, 0x21, 0xe3, 0xa0, 0xb6, 0x00, 0x21, 0x87, 0x84, 0xc6, 0xa0, 0x9c, 0xd0,
+	0x35, 0x45, 0x19, 0x48, 0x1d, 0x24, 0x83, 0x2f, 0xe0, 0x5c, 0x7f, 0xb6, 0xe8, 0x47, 0x57, 0x67,
+	0xce, 0x58, 0xb4, 0x1d, 0x28, 0xbf, 0x92, 0x58, 0xde, 0x05, 0x4a, 0xbd, 0xc6, 0x78, 0x5e, 0x45,
+	0x2c, 0x85, 0xcc, 0x86, 0xfa, 0x80, 0xa3, 0x1f, 0x65, 0x98, 0xbb, 0xde, 0xfc, 0xcf, 0x00, 0x8d,
+	0x29, 0xc3, 0x95, 0xc6, 0x29, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIServiceClient is the client API for APIService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIServiceClient interface {
+	// get the blockchain meta data
+	GetMeta(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ChainMeta, error)
+	// get candidates
+	GetCandidates(ctx context.Context, in *GetCandidatesRequest, opts ...grpc.CallOption) (*CandidateResponse, error)
+	// get candidate by name
+	GetCandidateByName(ctx context.Context, in *GetCandidateByNameRequest, opts ...grpc.CallOption) (*Candidate, error)
+	// get buckets by candidate
+	GetBucketsByCandidate(ctx context.Context, in *GetBucketsByCandidateRequest, opts ...grpc.CallOption) (*BucketResponse, error)
+	// get Buckets
+	GetBuckets(ctx context.Context, in *GetBucketsRequest, opts ...grpc.CallOption) (*BucketResponse, error)
+	// get the buckets, the totals and the backed delegates of a voter
+	GetVoter(ctx context.Context, in *GetVoterRequest, opts ...grpc.CallOption) (*VoterResponse, error)
+	// get the decentralization and concentration statistics of the result
+	GetStatistics(ctx context.Context, in *GetStatisticsRequest, opts ...grpc.CallOption) (*Statistics, error)
+	// health endpoint
+	IsHealth(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+	// get the detailed status of syncing
+	GetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SyncStatus, error)
+	// get the candidates and buckets excluded from the result
+	GetExclusions(ctx context.Context, in *GetExclusionsRequest, opts ...grpc.CallOption) (*ExclusionResponse, error)
+	// simulate the result with hypothetical changes
+	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+	// get the merkle roots of the result
+	GetResultRoot(ctx context.Context, in *GetResultRootRequest, opts ...grpc.CallOption) (*ResultRoot, error)
+	// get the inclusion proof of a candidate
+	GetCandidateProof(ctx context.Context, in *GetCandidateProofRequest, opts ...grpc.CallOption) (*MerkleProof, error)
+	// get the inclusion proof of a bucket
+	GetBucketProof(ctx context.Context, in *GetBucketProofRequest, opts ...grpc.CallOption) (*MerkleProof, error)
+	// get the consensus delegates and the block producers of an epoch, selected from the ranking adjusted
+	// by the probation list of the epoch
+	GetBlockProducers(ctx context.Context, in *GetBlockProducersRequest, opts ...grpc.CallOption) (*BlockProducersResponse, error)
+	// get the raw ranking along with the ranking adjusted by the probation list of an epoch
+	GetRankings(ctx context.Context, in *GetRankingsRequest, opts ...grpc.CallOption) (*RankingsResponse, error)
+	// put the probation list of an epoch, which is served on the gRPC port only rather than the gateway
+	PutProbationList(ctx context.Context, in *ProbationList, opts ...grpc.CallOption) (*empty.Empty, error)
+	// stream the summaries of the synced heights, along with heartbeats while idle
+	StreamResults(ctx context.Context, in *StreamResultsRequest, opts ...grpc.CallOption) (APIService_StreamResultsClient, error)
+	// get the nearest height with result before a time
+	GetHeightByTime(ctx context.Context, in *GetHeightByTimeRequest, opts ...grpc.CallOption) (*HeightResponse, error)
+	// get the summary of the nearest result before a time
+	GetResultByTime(ctx context.Context, in *GetResultByTimeRequest, opts ...grpc.CallOption) (*ResultSummary, error)
+	// get the time series of a candidate over a height or time range
+	GetCandidateHistory(ctx context.Context, in *GetCandidateHistoryRequest, opts ...grpc.CallOption) (*CandidateHistoryResponse, error)
+}
+
+type aPIServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIServiceClient(cc *grpc.ClientConn) APIServiceClient {
+	return &aPIServiceClient{cc}
+}
+
+func (c *aPIServiceClient) GetMeta(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ChainMeta, error) {
+	out := new(ChainMeta)
+	err := c.cc.Invoke(ctx, "/api.APIService/getMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetCandidates(ctx context.Context, in *GetCandidatesRequest, opts ...grpc.CallOption) (*CandidateResponse, error) {
+	out := new(CandidateResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/getCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetCandidateByName(ctx context.Context, in *GetCandidateByNameRequest, opts ...grpc.CallOption) (*Candidate, error) {
+	out := new(Candidate)
+	err := c.cc.Invoke(ctx, "/api.APIService/getCandidateByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetBucketsByCandidate(ctx context.Context, in *GetBucketsByCandidateRequest, opts ...grpc.CallOption) (*BucketResponse, error) {
+	out := new(BucketResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/getBucketsByCandidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetBuckets(ctx context.Context, in *GetBucketsRequest, opts ...grpc.CallOption) (*BucketResponse, error) {
+	out := new(BucketResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/getBuckets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetVoter(ctx context.Context, in *GetVoterRequest, opts ...grpc.CallOption) (*VoterResponse, error) {
+	out := new(VoterResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/getVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetStatistics(ctx context.Context, in *GetStatisticsRequest, opts ...grpc.CallOption) (*Statistics, error) {
+	out := new(Statistics)
+	err := c.cc.Invoke(ctx, "/api.APIService/getStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) IsHealth(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/isHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SyncStatus, error) {
+	out := new(SyncStatus)
+	err := c.cc.Invoke(ctx, "/api.APIService/getStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetExclusions(ctx context.Context, in *GetExclusionsRequest, opts ...grpc.CallOption) (*ExclusionResponse, error) {
+	out := new(ExclusionResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/getExclusions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error) {
+	out := new(SimulateResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/simulate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetResultRoot(ctx context.Context, in *GetResultRootRequest, opts ...grpc.CallOption) (*ResultRoot, error) {
+	out := new(ResultRoot)
+	err := c.cc.Invoke(ctx, "/api.APIService/getResultRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetCandidateProof(ctx context.Context, in *GetCandidateProofRequest, opts ...grpc.CallOption) (*MerkleProof, error) {
+	out := new(MerkleProof)
+	err := c.cc.Invoke(ctx, "/api.APIService/getCandidateProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetBucketProof(ctx context.Context, in *GetBucketProofRequest, opts ...grpc.CallOption) (*MerkleProof, error) {
+	out := new(MerkleProof)
+	err := c.cc.Invoke(ctx, "/api.APIService/getBucketProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetBlockProducers(ctx context.Context, in *GetBlockProducersRequest, opts ...grpc.CallOption) (*BlockProducersResponse, error) {
+	out := new(BlockProducersResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/getBlockProducers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetRankings(ctx context.Context, in *GetRankingsRequest, opts ...grpc.CallOption) (*RankingsResponse, error) {
+	out := new(RankingsResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/getRankings", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIServiceClient) GetCandidateByName(ctx context.Context, in *GetCandidateByNameRequest, opts ...grpc.CallOption) (*Candidate, error) {
-	out := new(Candidate)
-	err := c.cc.Invoke(ctx, "/api.APIService/getCandidateByName", in, out, opts...)
+func (c *aPIServiceClient) PutProbationList(ctx context.Context, in *ProbationList, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/putProbationList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIServiceClient) GetBucketsByCandidate(ctx context.Context, in *GetBucketsByCandidateRequest, opts ...grpc.CallOption) (*BucketResponse, error) {
-	out := new(BucketResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/getBucketsByCandidate", in, out, opts...)
+func (c *aPIServiceClient) StreamResults(ctx context.Context, in *StreamResultsRequest, opts ...grpc.CallOption) (APIService_StreamResultsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[0], "/api.APIService/streamResults", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceStreamResultsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_StreamResultsClient interface {
+	Recv() (*ResultEvent, error)
+	grpc.ClientStream
+}
+
+type aPIServiceStreamResultsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceStreamResultsClient) Recv() (*ResultEvent, error) {
+	m := new(ResultEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIServiceClient) GetHeightByTime(ctx context.Context, in *GetHeightByTimeRequest, opts ...grpc.CallOption) (*HeightResponse, error) {
+	out := new(HeightResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/getHeightByTime", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIServiceClient) GetBuckets(ctx context.Context, in *GetBucketsRequest, opts ...grpc.CallOption) (*BucketResponse, error) {
-	out := new(BucketResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/getBuckets", in, out, opts...)
+func (c *aPIServiceClient) GetResultByTime(ctx context.Context, in *GetResultByTimeRequest, opts ...grpc.CallOption) (*ResultSummary, error) {
+	out := new(ResultSummary)
+	err := c.cc.Invoke(ctx, "/api.APIService/getResultByTime", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIServiceClient) IsHealth(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
-	out := new(HealthCheckResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/isHealth", in, out, opts...)
+func (c *aPIServiceClient) GetCandidateHistory(ctx context.Context, in *GetCandidateHistoryRequest, opts ...grpc.CallOption) (*CandidateHistoryResponse, error) {
+	out := new(CandidateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/getCandidateHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -758,8 +3983,39 @@ type APIServiceServer interface {
 	GetBucketsByCandidate(context.Context, *GetBucketsByCandidateRequest) (*BucketResponse, error)
 	// get Buckets
 	GetBuckets(context.Context, *GetBucketsRequest) (*BucketResponse, error)
+	// get the buckets, the totals and the backed delegates of a voter
+	GetVoter(context.Context, *GetVoterRequest) (*VoterResponse, error)
+	// get the decentralization and concentration statistics of the result
+	GetStatistics(context.Context, *GetStatisticsRequest) (*Statistics, error)
 	// health endpoint
 	IsHealth(context.Context, *empty.Empty) (*HealthCheckResponse, error)
+	// get the detailed status of syncing
+	GetStatus(context.Context, *empty.Empty) (*SyncStatus, error)
+	// get the candidates and buckets excluded from the result
+	GetExclusions(context.Context, *GetExclusionsRequest) (*ExclusionResponse, error)
+	// simulate the result with hypothetical changes
+	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
+	// get the merkle roots of the result
+	GetResultRoot(context.Context, *GetResultRootRequest) (*ResultRoot, error)
+	// get the inclusion proof of a candidate
+	GetCandidateProof(context.Context, *GetCandidateProofRequest) (*MerkleProof, error)
+	// get the inclusion proof of a bucket
+	GetBucketProof(context.Context, *GetBucketProofRequest) (*MerkleProof, error)
+	// get the consensus delegates and the block producers of an epoch, selected from the ranking adjusted
+	// by the probation list of the epoch
+	GetBlockProducers(context.Context, *GetBlockProducersRequest) (*BlockProducersResponse, error)
+	// get the raw ranking along with the ranking adjusted by the probation list of an epoch
+	GetRankings(context.Context, *GetRankingsRequest) (*RankingsResponse, error)
+	// put the probation list of an epoch, which is served on the gRPC port only rather than the gateway
+	PutProbationList(context.Context, *ProbationList) (*empty.Empty, error)
+	// stream the summaries of the synced heights, along with heartbeats while idle
+	StreamResults(*StreamResultsRequest, APIService_StreamResultsServer) error
+	// get the nearest height with result before a time
+	GetHeightByTime(context.Context, *GetHeightByTimeRequest) (*HeightResponse, error)
+	// get the summary of the nearest result before a time
+	GetResultByTime(context.Context, *GetResultByTimeRequest) (*ResultSummary, error)
+	// get the time series of a candidate over a height or time range
+	GetCandidateHistory(context.Context, *GetCandidateHistoryRequest) (*CandidateHistoryResponse, error)
 }
 
 func RegisterAPIServiceServer(s *grpc.Server, srv APIServiceServer) {
@@ -856,6 +4112,42 @@ func _APIService_GetBuckets_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_GetVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/GetVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetVoter(ctx, req.(*GetVoterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/GetStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetStatistics(ctx, req.(*GetStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_IsHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -874,6 +4166,243 @@ func _APIService_IsHealth_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetExclusions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExclusionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetExclusions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/GetExclusions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetExclusions(ctx, req.(*GetExclusionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).Simulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/Simulate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).Simulate(ctx, req.(*SimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetResultRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResultRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetResultRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/GetResultRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetResultRoot(ctx, req.(*GetResultRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetCandidateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandidateProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetCandidateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/GetCandidateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetCandidateProof(ctx, req.(*GetCandidateProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetBucketProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetBucketProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/GetBucketProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetBucketProof(ctx, req.(*GetBucketProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetBlockProducers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockProducersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetBlockProducers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/GetBlockProducers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetBlockProducers(ctx, req.(*GetBlockProducersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetRankings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRankingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetRankings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/GetRankings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetRankings(ctx, req.(*GetRankingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_PutProbationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbationList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).PutProbationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/PutProbationList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).PutProbationList(ctx, req.(*ProbationList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_StreamResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamResultsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).StreamResults(m, &aPIServiceStreamResultsServer{stream})
+}
+
+type APIService_StreamResultsServer interface {
+	Send(*ResultEvent) error
+	grpc.ServerStream
+}
+
+type aPIServiceStreamResultsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceStreamResultsServer) Send(m *ResultEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _APIService_GetHeightByTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeightByTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetHeightByTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/GetHeightByTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetHeightByTime(ctx, req.(*GetHeightByTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetResultByTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResultByTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetResultByTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/GetResultByTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetResultByTime(ctx, req.(*GetResultByTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetCandidateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandidateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetCandidateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/GetCandidateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetCandidateHistory(ctx, req.(*GetCandidateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _APIService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.APIService",
 	HandlerType: (*APIServiceServer)(nil),
@@ -898,11 +4427,73 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "getBuckets",
 			Handler:    _APIService_GetBuckets_Handler,
 		},
+		{
+			MethodName: "getVoter",
+			Handler:    _APIService_GetVoter_Handler,
+		},
+		{
+			MethodName: "getStatistics",
+			Handler:    _APIService_GetStatistics_Handler,
+		},
 		{
 			MethodName: "isHealth",
 			Handler:    _APIService_IsHealth_Handler,
 		},
+		{
+			MethodName: "getStatus",
+			Handler:    _APIService_GetStatus_Handler,
+		},
+		{
+			MethodName: "getExclusions",
+			Handler:    _APIService_GetExclusions_Handler,
+		},
+		{
+			MethodName: "simulate",
+			Handler:    _APIService_Simulate_Handler,
+		},
+		{
+			MethodName: "getResultRoot",
+			Handler:    _APIService_GetResultRoot_Handler,
+		},
+		{
+			MethodName: "getCandidateProof",
+			Handler:    _APIService_GetCandidateProof_Handler,
+		},
+		{
+			MethodName: "getBucketProof",
+			Handler:    _APIService_GetBucketProof_Handler,
+		},
+		{
+			MethodName: "getBlockProducers",
+			Handler:    _APIService_GetBlockProducers_Handler,
+		},
+		{
+			MethodName: "getRankings",
+			Handler:    _APIService_GetRankings_Handler,
+		},
+		{
+			MethodName: "putProbationList",
+			Handler:    _APIService_PutProbationList_Handler,
+		},
+		{
+			MethodName: "getHeightByTime",
+			Handler:    _APIService_GetHeightByTime_Handler,
+		},
+		{
+			MethodName: "getResultByTime",
+			Handler:    _APIService_GetResultByTime_Handler,
+		},
+		{
+			MethodName: "getCandidateHistory",
+			Handler:    _APIService_GetCandidateHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "streamResults",
+			Handler:       _APIService_StreamResults_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
 }
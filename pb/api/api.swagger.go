@@ -0,0 +1,1626 @@
+// Code generated by compile.sh. DO NOT EDIT.
+
+package api
+
+// SwaggerJSON is the OpenAPI document of the REST gateway
+const SwaggerJSON = `{
+  "swagger": "2.0",
+  "info": {
+    "title": "api.proto",
+    "version": "version not set"
+  },
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "paths": {
+    "/v1/candidates/{name}/history": {
+      "get": {
+        "summary": "get the time series of a candidate over a height or time range",
+        "operationId": "getCandidateHistory",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiCandidateHistoryResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "description": "hex string",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "startHeight",
+            "description": "the range is given by either heights or times, inclusive, with the end defaulting to the latest.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "endHeight",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "startTime",
+            "description": "in RFC 3339 format in the http query.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "date-time"
+          },
+          {
+            "name": "endTime",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "date-time"
+          },
+          {
+            "name": "step",
+            "description": "the number of synced heights between two points, 1 if 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/health": {
+      "get": {
+        "summary": "health endpoint",
+        "operationId": "isHealth",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiHealthCheckResponse"
+            }
+          }
+        },
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/heights/by-time": {
+      "get": {
+        "summary": "get the nearest height with result before a time",
+        "operationId": "getHeightByTime",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiHeightResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "time",
+            "description": "in RFC 3339 format in the http query.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "date-time"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/heights/{height}/buckets": {
+      "get": {
+        "summary": "get Buckets",
+        "operationId": "getBuckets",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiBucketResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "height",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "sortBy",
+            "description": " - CANONICAL: the canonical order of the result",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "enum": [
+              "CANONICAL",
+              "AMOUNT",
+              "WEIGHTED_AMOUNT",
+              "REMAINING_DURATION",
+              "START_TIME",
+              "VOTER"
+            ],
+            "default": "CANONICAL"
+          },
+          {
+            "name": "descending",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "cursor",
+            "description": "the nextCursor of the previous page, which takes precedence over offset.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/heights/{height}/candidates": {
+      "get": {
+        "summary": "get candidates",
+        "operationId": "getCandidates",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiCandidateResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "height",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/heights/{height}/candidates/{name}": {
+      "get": {
+        "summary": "get candidate by name",
+        "operationId": "getCandidateByName",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiCandidate"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "height",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/heights/{height}/candidates/{name}/buckets": {
+      "get": {
+        "summary": "get buckets by candidate",
+        "operationId": "getBucketsByCandidate",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiBucketResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "height",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "sortBy",
+            "description": " - CANONICAL: the canonical order of the result",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "enum": [
+              "CANONICAL",
+              "AMOUNT",
+              "WEIGHTED_AMOUNT",
+              "REMAINING_DURATION",
+              "START_TIME",
+              "VOTER"
+            ],
+            "default": "CANONICAL"
+          },
+          {
+            "name": "descending",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "cursor",
+            "description": "the nextCursor of the previous page, which takes precedence over offset.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/heights/{height}/candidates/{name}/buckets/{index}/proof": {
+      "get": {
+        "summary": "get the inclusion proof of a bucket",
+        "operationId": "getBucketProof",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiMerkleProof"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "height",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "index",
+            "description": "index of the bucket in the buckets of the candidate",
+            "in": "path",
+            "required": true,
+            "type": "integer",
+            "format": "int64"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/heights/{height}/candidates/{name}/proof": {
+      "get": {
+        "summary": "get the inclusion proof of a candidate",
+        "operationId": "getCandidateProof",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiMerkleProof"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "height",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/heights/{height}/epochs/{epoch}/producers": {
+      "get": {
+        "summary": "get the consensus delegates and the block producers of an epoch, selected from the ranking adjusted\nby the probation list of the epoch",
+        "operationId": "getBlockProducers",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiBlockProducersResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "height",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "epoch",
+            "in": "path",
+            "required": true,
+            "type": "string",
+            "format": "uint64"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/heights/{height}/epochs/{epoch}/rankings": {
+      "get": {
+        "summary": "get the raw ranking along with the ranking adjusted by the probation list of an epoch",
+        "operationId": "getRankings",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiRankingsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "height",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "epoch",
+            "in": "path",
+            "required": true,
+            "type": "string",
+            "format": "uint64"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/heights/{height}/exclusions": {
+      "get": {
+        "summary": "get the candidates and buckets excluded from the result",
+        "operationId": "getExclusions",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiExclusionResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "height",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "name",
+            "description": "hex string, empty for exclusions of all candidates.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/heights/{height}/root": {
+      "get": {
+        "summary": "get the merkle roots of the result",
+        "operationId": "getResultRoot",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiResultRoot"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "height",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/heights/{height}/simulate": {
+      "post": {
+        "summary": "simulate the result with hypothetical changes",
+        "operationId": "simulate",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiSimulateResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "height",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiSimulateRequest"
+            }
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/heights/{height}/statistics": {
+      "get": {
+        "summary": "get the decentralization and concentration statistics of the result",
+        "operationId": "getStatistics",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiStatistics"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "height",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/heights/{height}/voters/{voter}": {
+      "get": {
+        "summary": "get the buckets, the totals and the backed delegates of a voter",
+        "operationId": "getVoter",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiVoterResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "height",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "voter",
+            "description": "hex or io1 string",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/meta": {
+      "get": {
+        "summary": "get the blockchain meta data",
+        "operationId": "getMeta",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiChainMeta"
+            }
+          }
+        },
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/results/by-time": {
+      "get": {
+        "summary": "get the summary of the nearest result before a time",
+        "operationId": "getResultByTime",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiResultSummary"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "time",
+            "description": "in RFC 3339 format in the http query.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "date-time"
+          },
+          {
+            "name": "topDelegates",
+            "description": "the number of top delegates in the summary, 36 if 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/results/stream": {
+      "get": {
+        "summary": "stream the summaries of the synced heights, along with heartbeats while idle",
+        "operationId": "streamResults",
+        "responses": {
+          "200": {
+            "description": "A successful response.(streaming responses)",
+            "schema": {
+              "$ref": "#/x-stream-definitions/apiResultEvent"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "fromHeight",
+            "description": "the height to resume from, inclusive, \"latest\", or empty to stream the heights synced from now on.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "topDelegates",
+            "description": "the number of top delegates in the summaries, 36 if 0.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          }
+        ],
+        "tags": [
+          "APIService"
+        ]
+      }
+    },
+    "/v1/status": {
+      "get": {
+        "summary": "get the detailed status of syncing",
+        "operationId": "getStatus",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiSyncStatus"
+            }
+          }
+        },
+        "tags": [
+          "APIService"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "HealthCheckResponseStatus": {
+      "type": "string",
+      "enum": [
+        "STARTING",
+        "ACTIVE",
+        "INACTIVE"
+      ],
+      "default": "STARTING"
+    },
+    "apiBlockProducersResponse": {
+      "type": "object",
+      "properties": {
+        "height": {
+          "type": "string"
+        },
+        "epoch": {
+          "type": "string",
+          "format": "uint64"
+        },
+        "consensusDelegates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiCandidate"
+          },
+          "title": "in rank order"
+        },
+        "blockProducers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiCandidate"
+          },
+          "title": "in the order of producing blocks in the epoch"
+        }
+      }
+    },
+    "apiBucket": {
+      "type": "object",
+      "properties": {
+        "voter": {
+          "type": "string",
+          "title": "hex string"
+        },
+        "votes": {
+          "type": "string"
+        },
+        "weightedVotes": {
+          "type": "string"
+        },
+        "remainingDuration": {
+          "type": "string",
+          "title": "human readable duration"
+        },
+        "voterIoAddress": {
+          "type": "string",
+          "title": "io1 address of the voter"
+        }
+      }
+    },
+    "apiBucketResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiBucket"
+          }
+        },
+        "nextCursor": {
+          "type": "string",
+          "title": "the cursor of the next page, empty if there is no more buckets"
+        },
+        "height": {
+          "type": "string",
+          "title": "the resolved height"
+        }
+      }
+    },
+    "apiBucketSelector": {
+      "type": "object",
+      "properties": {
+        "voter": {
+          "type": "string",
+          "title": "hex or io1 string"
+        },
+        "candidate": {
+          "type": "string",
+          "title": "hex string, empty for buckets of all candidates"
+        }
+      }
+    },
+    "apiBucketSortKey": {
+      "type": "string",
+      "enum": [
+        "CANONICAL",
+        "AMOUNT",
+        "WEIGHTED_AMOUNT",
+        "REMAINING_DURATION",
+        "START_TIME",
+        "VOTER"
+      ],
+      "default": "CANONICAL",
+      "title": "- CANONICAL: the canonical order of the result"
+    },
+    "apiCandidate": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "address": {
+          "type": "string",
+          "title": "hex string"
+        },
+        "totalWeightedVotes": {
+          "type": "string"
+        },
+        "selfStakingTokens": {
+          "type": "string"
+        },
+        "operatorAddress": {
+          "type": "string",
+          "title": "io1 address, empty if unregistered or malformed"
+        },
+        "rewardAddress": {
+          "type": "string",
+          "title": "io1 address, empty if unregistered or malformed"
+        },
+        "ioAddress": {
+          "type": "string",
+          "title": "io1 address of the candidate on gravity chain"
+        },
+        "malformedAddress": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "true if the registered operator or reward address is malformed"
+        },
+        "height": {
+          "type": "string",
+          "title": "the resolved height if the candidate is the response of getCandidateByName, empty otherwise"
+        }
+      }
+    },
+    "apiCandidateHistoryPoint": {
+      "type": "object",
+      "properties": {
+        "height": {
+          "type": "string"
+        },
+        "mintTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "rank": {
+          "type": "integer",
+          "format": "int64",
+          "title": "1-based rank in the result, 0 if the candidate is not in the result"
+        },
+        "score": {
+          "type": "string"
+        },
+        "selfStakingTokens": {
+          "type": "string"
+        },
+        "voterCount": {
+          "type": "integer",
+          "format": "int64",
+          "title": "0 if pruned is true"
+        },
+        "qualified": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "true if the candidate is above the score and self staking thresholds"
+        },
+        "pruned": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "true if the votes of the result were pruned before its statistics were stored, so that the\nnumber of voters is unknown"
+        }
+      }
+    },
+    "apiCandidateHistoryResponse": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "points": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiCandidateHistoryPoint"
+          },
+          "title": "in height order"
+        }
+      }
+    },
+    "apiCandidateResponse": {
+      "type": "object",
+      "properties": {
+        "candidates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiCandidate"
+          }
+        },
+        "height": {
+          "type": "string",
+          "title": "the resolved height"
+        }
+      }
+    },
+    "apiChainMeta": {
+      "type": "object",
+      "properties": {
+        "height": {
+          "type": "string"
+        },
+        "totalCandidates": {
+          "type": "string",
+          "format": "uint64"
+        },
+        "totalVotedStakes": {
+          "type": "string"
+        },
+        "totalVotes": {
+          "type": "string"
+        }
+      }
+    },
+    "apiConsistencyFailure": {
+      "type": "object",
+      "properties": {
+        "height": {
+          "type": "string"
+        },
+        "cause": {
+          "type": "string"
+        },
+        "attempts": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "firstFailure": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "lastFailure": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "nextRetry": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "apiDelegateChange": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "oldRank": {
+          "type": "integer",
+          "format": "int64",
+          "title": "1-based rank, 0 if not a delegate"
+        },
+        "newRank": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "oldVotes": {
+          "type": "string"
+        },
+        "newVotes": {
+          "type": "string"
+        }
+      }
+    },
+    "apiDelegateVoters": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "uniqueVoters": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "apiDurationChange": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "$ref": "#/definitions/apiBucketSelector"
+        },
+        "duration": {
+          "type": "string"
+        }
+      }
+    },
+    "apiExcludedBucket": {
+      "type": "object",
+      "properties": {
+        "voter": {
+          "type": "string",
+          "title": "hex string"
+        },
+        "candidate": {
+          "type": "string",
+          "title": "hex string"
+        },
+        "votes": {
+          "type": "string"
+        },
+        "reason": {
+          "$ref": "#/definitions/apiExclusionReason"
+        }
+      }
+    },
+    "apiExcludedCandidate": {
+      "type": "object",
+      "properties": {
+        "candidate": {
+          "$ref": "#/definitions/apiCandidate"
+        },
+        "reason": {
+          "$ref": "#/definitions/apiExclusionReason"
+        }
+      }
+    },
+    "apiExclusionReason": {
+      "type": "string",
+      "enum": [
+        "UNKNOWN_REASON",
+        "MANIFIED_CANDIDATE",
+        "FILTERED_CANDIDATE",
+        "FILTERED_VOTE",
+        "ZERO_CANDIDATE_VOTE",
+        "UNKNOWN_CANDIDATE_VOTE",
+        "EXCLUDED_CANDIDATE_VOTE",
+        "LOW_SCORE_CANDIDATE",
+        "LOW_SELF_STAKING_CANDIDATE"
+      ],
+      "default": "UNKNOWN_REASON"
+    },
+    "apiExclusionResponse": {
+      "type": "object",
+      "properties": {
+        "candidates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiExcludedCandidate"
+          }
+        },
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiExcludedBucket"
+          }
+        },
+        "pruned": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "true if the excluded buckets have been pruned"
+        },
+        "malformed": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiCandidate"
+          },
+          "title": "the candidates registered with malformed addresses, which are not excluded"
+        },
+        "height": {
+          "type": "string",
+          "title": "the resolved height"
+        }
+      }
+    },
+    "apiHealthCheckResponse": {
+      "type": "object",
+      "properties": {
+        "status": {
+          "$ref": "#/definitions/HealthCheckResponseStatus"
+        },
+        "failures": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiConsistencyFailure"
+          },
+          "description": "the heights failed to be synced, which are being retried. The status is not affected by them,\nand the committee is degraded if any."
+        }
+      }
+    },
+    "apiHeartbeat": {
+      "type": "object",
+      "properties": {
+        "latestHeight": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "apiHeightResponse": {
+      "type": "object",
+      "properties": {
+        "height": {
+          "type": "string"
+        },
+        "mintTime": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "apiMerkleProof": {
+      "type": "object",
+      "properties": {
+        "root": {
+          "type": "string",
+          "title": "hex string of the root"
+        },
+        "leaf": {
+          "type": "string",
+          "title": "hex string of the serialized candidate or vote"
+        },
+        "steps": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiProofStep"
+          }
+        },
+        "height": {
+          "type": "string",
+          "title": "the resolved height"
+        }
+      }
+    },
+    "apiProbationList": {
+      "type": "object",
+      "properties": {
+        "epoch": {
+          "type": "string",
+          "format": "uint64"
+        },
+        "intensityRate": {
+          "type": "integer",
+          "format": "int64",
+          "title": "the percentage of score reduction, in which 100 excludes the delegates, and 0 for the default"
+        },
+        "delegates": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "hex strings of names"
+        }
+      }
+    },
+    "apiProofStep": {
+      "type": "object",
+      "properties": {
+        "hash": {
+          "type": "string",
+          "title": "hex string"
+        },
+        "left": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "true if the sibling is the left child"
+        }
+      }
+    },
+    "apiRanking": {
+      "type": "object",
+      "properties": {
+        "candidate": {
+          "$ref": "#/definitions/apiCandidate",
+          "title": "the candidate with raw score"
+        },
+        "rawRank": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rank": {
+          "type": "integer",
+          "format": "int64",
+          "title": "0 if excluded"
+        },
+        "adjustedScore": {
+          "type": "string"
+        },
+        "probation": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "excluded": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      }
+    },
+    "apiRankingsResponse": {
+      "type": "object",
+      "properties": {
+        "height": {
+          "type": "string"
+        },
+        "probationList": {
+          "$ref": "#/definitions/apiProbationList"
+        },
+        "rankings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiRanking"
+          },
+          "title": "in the raw ranking order"
+        }
+      }
+    },
+    "apiResultEvent": {
+      "type": "object",
+      "properties": {
+        "result": {
+          "$ref": "#/definitions/apiResultSummary"
+        },
+        "heartbeat": {
+          "$ref": "#/definitions/apiHeartbeat"
+        }
+      }
+    },
+    "apiResultRoot": {
+      "type": "object",
+      "properties": {
+        "height": {
+          "type": "string"
+        },
+        "root": {
+          "type": "string",
+          "title": "hex string"
+        },
+        "delegatesRoot": {
+          "type": "string",
+          "title": "hex string"
+        },
+        "votesRoot": {
+          "type": "string",
+          "title": "hex string"
+        }
+      }
+    },
+    "apiResultSummary": {
+      "type": "object",
+      "properties": {
+        "height": {
+          "type": "string"
+        },
+        "mintTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "topDelegates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiCandidate"
+          },
+          "title": "in rank order"
+        },
+        "totalVotes": {
+          "type": "string"
+        },
+        "totalVotedStakes": {
+          "type": "string"
+        },
+        "totalCandidates": {
+          "type": "string",
+          "format": "uint64"
+        }
+      }
+    },
+    "apiSimulateRequest": {
+      "type": "object",
+      "properties": {
+        "height": {
+          "type": "string"
+        },
+        "removeBuckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiBucketSelector"
+          }
+        },
+        "moveVotes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiVoteMove"
+          }
+        },
+        "changeDurations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiDurationChange"
+          }
+        },
+        "addBuckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiSimulatedBucket"
+          }
+        },
+        "voteThreshold": {
+          "type": "string",
+          "description": "thresholds are kept as configured if empty. Lowered thresholds admit the candidates and votes\nexcluded from the result, and are refused if the exclusions have been pruned."
+        },
+        "scoreThreshold": {
+          "type": "string"
+        },
+        "selfStakingThreshold": {
+          "type": "string"
+        }
+      }
+    },
+    "apiSimulateResponse": {
+      "type": "object",
+      "properties": {
+        "candidates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiCandidate"
+          },
+          "title": "the simulated ranking"
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiDelegateChange"
+          }
+        },
+        "height": {
+          "type": "string",
+          "title": "the resolved height"
+        }
+      }
+    },
+    "apiSimulatedBucket": {
+      "type": "object",
+      "properties": {
+        "voter": {
+          "type": "string",
+          "title": "hex or io1 string"
+        },
+        "candidate": {
+          "type": "string",
+          "title": "hex string"
+        },
+        "amount": {
+          "type": "string"
+        },
+        "startTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "duration": {
+          "type": "string"
+        },
+        "decay": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      }
+    },
+    "apiStatistics": {
+      "type": "object",
+      "properties": {
+        "height": {
+          "type": "string"
+        },
+        "nakamotoCoefficient": {
+          "type": "integer",
+          "format": "int64",
+          "title": "the minimum number of delegates whose scores add up to more than half of the total"
+        },
+        "voterGini": {
+          "type": "number",
+          "format": "double",
+          "title": "the gini coefficient of voter stakes"
+        },
+        "topVoterShares": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiTopVoterShare"
+          }
+        },
+        "delegateVoters": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiDelegateVoters"
+          }
+        },
+        "selfStakingShare": {
+          "type": "number",
+          "format": "double"
+        },
+        "totalVoters": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "apiSyncStatus": {
+      "type": "object",
+      "properties": {
+        "status": {
+          "$ref": "#/definitions/HealthCheckResponseStatus"
+        },
+        "tipHeight": {
+          "type": "string"
+        },
+        "tipTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "lastSyncedHeight": {
+          "type": "string"
+        },
+        "lastSyncedTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "lagHeights": {
+          "type": "string",
+          "format": "uint64",
+          "title": "number of gravity chain blocks behind the tip"
+        },
+        "lagDuration": {
+          "type": "string"
+        },
+        "pendingHeights": {
+          "type": "string",
+          "format": "uint64",
+          "title": "number of heights waiting to be synced"
+        },
+        "failures": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiConsistencyFailure"
+          },
+          "title": "the quarantined heights with the last error of each"
+        },
+        "endpoint": {
+          "type": "string",
+          "title": "the gravity chain api in use"
+        },
+        "catchUpStartHeight": {
+          "type": "string"
+        },
+        "catchUpTargetHeight": {
+          "type": "string"
+        },
+        "catchUpProgress": {
+          "type": "number",
+          "format": "double",
+          "title": "ratio of synced heights in the catch-up range"
+        }
+      }
+    },
+    "apiTopVoterShare": {
+      "type": "object",
+      "properties": {
+        "top": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "share": {
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "apiVoteMove": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "$ref": "#/definitions/apiBucketSelector"
+        },
+        "to": {
+          "type": "string",
+          "title": "hex string of the new candidate"
+        }
+      }
+    },
+    "apiVotedDelegate": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "votes": {
+          "type": "string"
+        },
+        "weightedVotes": {
+          "type": "string"
+        }
+      }
+    },
+    "apiVoterResponse": {
+      "type": "object",
+      "properties": {
+        "voter": {
+          "type": "string",
+          "title": "hex string"
+        },
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiBucket"
+          }
+        },
+        "totalVotes": {
+          "type": "string"
+        },
+        "totalWeightedVotes": {
+          "type": "string"
+        },
+        "delegates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiVotedDelegate"
+          },
+          "title": "in the order of the ranks of the delegates"
+        },
+        "voterIoAddress": {
+          "type": "string",
+          "title": "io1 address of the voter"
+        },
+        "height": {
+          "type": "string",
+          "title": "the resolved height"
+        }
+      }
+    },
+    "protobufAny": {
+      "type": "object",
+      "properties": {
+        "type_url": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string",
+          "format": "byte"
+        }
+      }
+    },
+    "runtimeStreamError": {
+      "type": "object",
+      "properties": {
+        "grpc_code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "http_code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "message": {
+          "type": "string"
+        },
+        "http_status": {
+          "type": "string"
+        },
+        "details": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/protobufAny"
+          }
+        }
+      }
+    }
+  },
+  "x-stream-definitions": {
+    "apiResultEvent": {
+      "type": "object",
+      "properties": {
+        "result": {
+          "$ref": "#/definitions/apiResultEvent"
+        },
+        "error": {
+          "$ref": "#/definitions/runtimeStreamError"
+        }
+      },
+      "title": "Stream result of apiResultEvent"
+    }
+  }
+}
+`
@@ -6,7 +6,28 @@
 # Update protoc Go bindings via
 #  go get -u github.com/golang/protobuf/{proto,protoc-gen-go}
 #
+# Install the REST gateway and OpenAPI generators via
+#  go get -u github.com/grpc-ecosystem/grpc-gateway/{protoc-gen-grpc-gateway,protoc-gen-swagger}
+#
 # See also
 #  https://github.com/grpc/grpc-go/tree/master/examples
+#  https://github.com/grpc-ecosystem/grpc-gateway
+
+GOOGLEAPIS=${GOOGLEAPIS:-$(go list -m -f '{{.Dir}}' github.com/grpc-ecosystem/grpc-gateway)/third_party/googleapis}
+
+protoc -I. -I"$GOOGLEAPIS" ./api.proto \
+	--go_out=plugins=grpc:. \
+	--grpc-gateway_out=logtostderr=true:. \
+	--swagger_out=logtostderr=true:.
 
-protoc ./api.proto --go_out=plugins=grpc:.
+# embed the OpenAPI document into the binary
+{
+	echo "// Code generated by compile.sh. DO NOT EDIT."
+	echo
+	echo "package api"
+	echo
+	echo "// SwaggerJSON is the OpenAPI document of the REST gateway"
+	printf 'const SwaggerJSON = `'
+	cat api.swagger.json
+	echo '`'
+} > api.swagger.go
@@ -3,11 +3,13 @@
 
 package election
 
-import proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import duration "github.com/golang/protobuf/ptypes/duration"
-import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	math "math"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -18,7 +20,61 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ExclusionReason int32
+
+const (
+	ExclusionReason_UNKNOWN_REASON ExclusionReason = 0
+	// the candidate is manified and manified candidates are skipped
+	ExclusionReason_MANIFIED_CANDIDATE ExclusionReason = 1
+	// the candidate fails the candidate filter, e.g., thresholds of score and self staking
+	ExclusionReason_FILTERED_CANDIDATE ExclusionReason = 2
+	// the vote fails the vote filter, e.g., threshold of amount
+	ExclusionReason_FILTERED_VOTE ExclusionReason = 3
+	// the vote is for the zero candidate or no candidate
+	ExclusionReason_ZERO_CANDIDATE_VOTE ExclusionReason = 4
+	// the vote is for a candidate not registered or skipped
+	ExclusionReason_UNKNOWN_CANDIDATE_VOTE ExclusionReason = 5
+	// the vote is for a candidate excluded from the result
+	ExclusionReason_EXCLUDED_CANDIDATE_VOTE ExclusionReason = 6
+	// the candidate is below the score threshold
+	ExclusionReason_LOW_SCORE_CANDIDATE ExclusionReason = 7
+	// the candidate is below the self staking threshold, which is checked before the score
+	ExclusionReason_LOW_SELF_STAKING_CANDIDATE ExclusionReason = 8
+)
+
+var ExclusionReason_name = map[int32]string{
+	0: "UNKNOWN_REASON",
+	1: "MANIFIED_CANDIDATE",
+	2: "FILTERED_CANDIDATE",
+	3: "FILTERED_VOTE",
+	4: "ZERO_CANDIDATE_VOTE",
+	5: "UNKNOWN_CANDIDATE_VOTE",
+	6: "EXCLUDED_CANDIDATE_VOTE",
+	7: "LOW_SCORE_CANDIDATE",
+	8: "LOW_SELF_STAKING_CANDIDATE",
+}
+
+var ExclusionReason_value = map[string]int32{
+	"UNKNOWN_REASON":             0,
+	"MANIFIED_CANDIDATE":         1,
+	"FILTERED_CANDIDATE":         2,
+	"FILTERED_VOTE":              3,
+	"ZERO_CANDIDATE_VOTE":        4,
+	"UNKNOWN_CANDIDATE_VOTE":     5,
+	"EXCLUDED_CANDIDATE_VOTE":    6,
+	"LOW_SCORE_CANDIDATE":        7,
+	"LOW_SELF_STAKING_CANDIDATE": 8,
+}
+
+func (x ExclusionReason) String() string {
+	return proto.EnumName(ExclusionReason_name, int32(x))
+}
+
+func (ExclusionReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{0}
+}
 
 type Vote struct {
 	Voter                []byte               `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
@@ -37,16 +93,17 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_election_b0a13835e5dd684b, []int{0}
+	return fileDescriptor_64dbf621b3c93457, []int{0}
 }
+
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Vote.Unmarshal(m, b)
 }
 func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
 }
-func (dst *Vote) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Vote.Merge(dst, src)
+func (m *Vote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vote.Merge(m, src)
 }
 func (m *Vote) XXX_Size() int {
 	return xxx_messageInfo_Vote.Size(m)
@@ -117,16 +174,17 @@ func (m *VoteList) Reset()         { *m = VoteList{} }
 func (m *VoteList) String() string { return proto.CompactTextString(m) }
 func (*VoteList) ProtoMessage()    {}
 func (*VoteList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_election_b0a13835e5dd684b, []int{1}
+	return fileDescriptor_64dbf621b3c93457, []int{1}
 }
+
 func (m *VoteList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VoteList.Unmarshal(m, b)
 }
 func (m *VoteList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_VoteList.Marshal(b, m, deterministic)
 }
-func (dst *VoteList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VoteList.Merge(dst, src)
+func (m *VoteList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteList.Merge(m, src)
 }
 func (m *VoteList) XXX_Size() int {
 	return xxx_messageInfo_VoteList.Size(m)
@@ -161,16 +219,17 @@ func (m *Candidate) Reset()         { *m = Candidate{} }
 func (m *Candidate) String() string { return proto.CompactTextString(m) }
 func (*Candidate) ProtoMessage()    {}
 func (*Candidate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_election_b0a13835e5dd684b, []int{2}
+	return fileDescriptor_64dbf621b3c93457, []int{2}
 }
+
 func (m *Candidate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Candidate.Unmarshal(m, b)
 }
 func (m *Candidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Candidate.Marshal(b, m, deterministic)
 }
-func (dst *Candidate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Candidate.Merge(dst, src)
+func (m *Candidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candidate.Merge(m, src)
 }
 func (m *Candidate) XXX_Size() int {
 	return xxx_messageInfo_Candidate.Size(m)
@@ -231,30 +290,34 @@ func (m *Candidate) GetSelfStakingTokens() []byte {
 }
 
 type ElectionResult struct {
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Delegates            []*Candidate         `protobuf:"bytes,2,rep,name=delegates,proto3" json:"delegates,omitempty"`
-	DelegateVotes        []*VoteList          `protobuf:"bytes,3,rep,name=delegateVotes,proto3" json:"delegateVotes,omitempty"`
-	TotalVotedStakes     []byte               `protobuf:"bytes,4,opt,name=totalVotedStakes,proto3" json:"totalVotedStakes,omitempty"`
-	TotalVotes           []byte               `protobuf:"bytes,5,opt,name=totalVotes,proto3" json:"totalVotes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Timestamp        *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Delegates        []*Candidate         `protobuf:"bytes,2,rep,name=delegates,proto3" json:"delegates,omitempty"`
+	DelegateVotes    []*VoteList          `protobuf:"bytes,3,rep,name=delegateVotes,proto3" json:"delegateVotes,omitempty"`
+	TotalVotedStakes []byte               `protobuf:"bytes,4,opt,name=totalVotedStakes,proto3" json:"totalVotedStakes,omitempty"`
+	TotalVotes       []byte               `protobuf:"bytes,5,opt,name=totalVotes,proto3" json:"totalVotes,omitempty"`
+	Pruned           bool                 `protobuf:"varint,6,opt,name=pruned,proto3" json:"pruned,omitempty"`
+	// the adjustments of an adjusted ranking, empty for a raw result
+	Adjustments          []*Adjustment `protobuf:"bytes,7,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ElectionResult) Reset()         { *m = ElectionResult{} }
 func (m *ElectionResult) String() string { return proto.CompactTextString(m) }
 func (*ElectionResult) ProtoMessage()    {}
 func (*ElectionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_election_b0a13835e5dd684b, []int{3}
+	return fileDescriptor_64dbf621b3c93457, []int{3}
 }
+
 func (m *ElectionResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ElectionResult.Unmarshal(m, b)
 }
 func (m *ElectionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ElectionResult.Marshal(b, m, deterministic)
 }
-func (dst *ElectionResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ElectionResult.Merge(dst, src)
+func (m *ElectionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ElectionResult.Merge(m, src)
 }
 func (m *ElectionResult) XXX_Size() int {
 	return xxx_messageInfo_ElectionResult.Size(m)
@@ -300,43 +363,936 @@ func (m *ElectionResult) GetTotalVotes() []byte {
 	return nil
 }
 
+func (m *ElectionResult) GetPruned() bool {
+	if m != nil {
+		return m.Pruned
+	}
+	return false
+}
+
+func (m *ElectionResult) GetAdjustments() []*Adjustment {
+	if m != nil {
+		return m.Adjustments
+	}
+	return nil
+}
+
+// VoteRun copies count votes of the base result starting from offset if count is positive, or
+// inserts vote otherwise
+type VoteRun struct {
+	Offset               uint32   `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Vote                 *Vote    `protobuf:"bytes,3,opt,name=vote,proto3" json:"vote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoteRun) Reset()         { *m = VoteRun{} }
+func (m *VoteRun) String() string { return proto.CompactTextString(m) }
+func (*VoteRun) ProtoMessage()    {}
+func (*VoteRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{4}
+}
+
+func (m *VoteRun) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteRun.Unmarshal(m, b)
+}
+func (m *VoteRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteRun.Marshal(b, m, deterministic)
+}
+func (m *VoteRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteRun.Merge(m, src)
+}
+func (m *VoteRun) XXX_Size() int {
+	return xxx_messageInfo_VoteRun.Size(m)
+}
+func (m *VoteRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteRun.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteRun proto.InternalMessageInfo
+
+func (m *VoteRun) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *VoteRun) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *VoteRun) GetVote() *Vote {
+	if m != nil {
+		return m.Vote
+	}
+	return nil
+}
+
+type VoteListDelta struct {
+	Runs []*VoteRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	// the positions of the copied votes whose weighted amounts changed
+	Reweighted           []uint32 `protobuf:"varint,2,rep,packed,name=reweighted,proto3" json:"reweighted,omitempty"`
+	WeightedAmounts      [][]byte `protobuf:"bytes,3,rep,name=weightedAmounts,proto3" json:"weightedAmounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoteListDelta) Reset()         { *m = VoteListDelta{} }
+func (m *VoteListDelta) String() string { return proto.CompactTextString(m) }
+func (*VoteListDelta) ProtoMessage()    {}
+func (*VoteListDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{5}
+}
+
+func (m *VoteListDelta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteListDelta.Unmarshal(m, b)
+}
+func (m *VoteListDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteListDelta.Marshal(b, m, deterministic)
+}
+func (m *VoteListDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteListDelta.Merge(m, src)
+}
+func (m *VoteListDelta) XXX_Size() int {
+	return xxx_messageInfo_VoteListDelta.Size(m)
+}
+func (m *VoteListDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteListDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteListDelta proto.InternalMessageInfo
+
+func (m *VoteListDelta) GetRuns() []*VoteRun {
+	if m != nil {
+		return m.Runs
+	}
+	return nil
+}
+
+func (m *VoteListDelta) GetReweighted() []uint32 {
+	if m != nil {
+		return m.Reweighted
+	}
+	return nil
+}
+
+func (m *VoteListDelta) GetWeightedAmounts() [][]byte {
+	if m != nil {
+		return m.WeightedAmounts
+	}
+	return nil
+}
+
+// ResultDelta is a result encoded against the result of a previous height
+type ResultDelta struct {
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Delegates            []*Candidate         `protobuf:"bytes,2,rep,name=delegates,proto3" json:"delegates,omitempty"`
+	DelegateVotes        []*VoteListDelta     `protobuf:"bytes,3,rep,name=delegateVotes,proto3" json:"delegateVotes,omitempty"`
+	TotalVotedStakes     []byte               `protobuf:"bytes,4,opt,name=totalVotedStakes,proto3" json:"totalVotedStakes,omitempty"`
+	TotalVotes           []byte               `protobuf:"bytes,5,opt,name=totalVotes,proto3" json:"totalVotes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ResultDelta) Reset()         { *m = ResultDelta{} }
+func (m *ResultDelta) String() string { return proto.CompactTextString(m) }
+func (*ResultDelta) ProtoMessage()    {}
+func (*ResultDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{6}
+}
+
+func (m *ResultDelta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResultDelta.Unmarshal(m, b)
+}
+func (m *ResultDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResultDelta.Marshal(b, m, deterministic)
+}
+func (m *ResultDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultDelta.Merge(m, src)
+}
+func (m *ResultDelta) XXX_Size() int {
+	return xxx_messageInfo_ResultDelta.Size(m)
+}
+func (m *ResultDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResultDelta proto.InternalMessageInfo
+
+func (m *ResultDelta) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *ResultDelta) GetDelegates() []*Candidate {
+	if m != nil {
+		return m.Delegates
+	}
+	return nil
+}
+
+func (m *ResultDelta) GetDelegateVotes() []*VoteListDelta {
+	if m != nil {
+		return m.DelegateVotes
+	}
+	return nil
+}
+
+func (m *ResultDelta) GetTotalVotedStakes() []byte {
+	if m != nil {
+		return m.TotalVotedStakes
+	}
+	return nil
+}
+
+func (m *ResultDelta) GetTotalVotes() []byte {
+	if m != nil {
+		return m.TotalVotes
+	}
+	return nil
+}
+
+// StoredResult is the record of a result in db, whose data is a compressed ElectionResult if it is a
+// keyframe, or a compressed ResultDelta against the result of baseHeight otherwise
+type StoredResult struct {
+	Delta                bool     `protobuf:"varint,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	BaseHeight           uint64   `protobuf:"varint,2,opt,name=baseHeight,proto3" json:"baseHeight,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StoredResult) Reset()         { *m = StoredResult{} }
+func (m *StoredResult) String() string { return proto.CompactTextString(m) }
+func (*StoredResult) ProtoMessage()    {}
+func (*StoredResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{7}
+}
+
+func (m *StoredResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoredResult.Unmarshal(m, b)
+}
+func (m *StoredResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoredResult.Marshal(b, m, deterministic)
+}
+func (m *StoredResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoredResult.Merge(m, src)
+}
+func (m *StoredResult) XXX_Size() int {
+	return xxx_messageInfo_StoredResult.Size(m)
+}
+func (m *StoredResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoredResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoredResult proto.InternalMessageInfo
+
+func (m *StoredResult) GetDelta() bool {
+	if m != nil {
+		return m.Delta
+	}
+	return false
+}
+
+func (m *StoredResult) GetBaseHeight() uint64 {
+	if m != nil {
+		return m.BaseHeight
+	}
+	return 0
+}
+
+func (m *StoredResult) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ResultCommitment struct {
+	Root                 []byte   `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	DelegatesRoot        []byte   `protobuf:"bytes,2,opt,name=delegatesRoot,proto3" json:"delegatesRoot,omitempty"`
+	VotesRoot            []byte   `protobuf:"bytes,3,opt,name=votesRoot,proto3" json:"votesRoot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResultCommitment) Reset()         { *m = ResultCommitment{} }
+func (m *ResultCommitment) String() string { return proto.CompactTextString(m) }
+func (*ResultCommitment) ProtoMessage()    {}
+func (*ResultCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{8}
+}
+
+func (m *ResultCommitment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResultCommitment.Unmarshal(m, b)
+}
+func (m *ResultCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResultCommitment.Marshal(b, m, deterministic)
+}
+func (m *ResultCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultCommitment.Merge(m, src)
+}
+func (m *ResultCommitment) XXX_Size() int {
+	return xxx_messageInfo_ResultCommitment.Size(m)
+}
+func (m *ResultCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResultCommitment proto.InternalMessageInfo
+
+func (m *ResultCommitment) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *ResultCommitment) GetDelegatesRoot() []byte {
+	if m != nil {
+		return m.DelegatesRoot
+	}
+	return nil
+}
+
+func (m *ResultCommitment) GetVotesRoot() []byte {
+	if m != nil {
+		return m.VotesRoot
+	}
+	return nil
+}
+
+type ExcludedCandidate struct {
+	Candidate            *Candidate      `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Reason               ExclusionReason `protobuf:"varint,2,opt,name=reason,proto3,enum=election.ExclusionReason" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ExcludedCandidate) Reset()         { *m = ExcludedCandidate{} }
+func (m *ExcludedCandidate) String() string { return proto.CompactTextString(m) }
+func (*ExcludedCandidate) ProtoMessage()    {}
+func (*ExcludedCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{9}
+}
+
+func (m *ExcludedCandidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExcludedCandidate.Unmarshal(m, b)
+}
+func (m *ExcludedCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExcludedCandidate.Marshal(b, m, deterministic)
+}
+func (m *ExcludedCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExcludedCandidate.Merge(m, src)
+}
+func (m *ExcludedCandidate) XXX_Size() int {
+	return xxx_messageInfo_ExcludedCandidate.Size(m)
+}
+func (m *ExcludedCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExcludedCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExcludedCandidate proto.InternalMessageInfo
+
+func (m *ExcludedCandidate) GetCandidate() *Candidate {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+func (m *ExcludedCandidate) GetReason() ExclusionReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ExclusionReason_UNKNOWN_REASON
+}
+
+type ExcludedVote struct {
+	Vote                 *Vote           `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
+	Reason               ExclusionReason `protobuf:"varint,2,opt,name=reason,proto3,enum=election.ExclusionReason" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ExcludedVote) Reset()         { *m = ExcludedVote{} }
+func (m *ExcludedVote) String() string { return proto.CompactTextString(m) }
+func (*ExcludedVote) ProtoMessage()    {}
+func (*ExcludedVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{10}
+}
+
+func (m *ExcludedVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExcludedVote.Unmarshal(m, b)
+}
+func (m *ExcludedVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExcludedVote.Marshal(b, m, deterministic)
+}
+func (m *ExcludedVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExcludedVote.Merge(m, src)
+}
+func (m *ExcludedVote) XXX_Size() int {
+	return xxx_messageInfo_ExcludedVote.Size(m)
+}
+func (m *ExcludedVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExcludedVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExcludedVote proto.InternalMessageInfo
+
+func (m *ExcludedVote) GetVote() *Vote {
+	if m != nil {
+		return m.Vote
+	}
+	return nil
+}
+
+func (m *ExcludedVote) GetReason() ExclusionReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ExclusionReason_UNKNOWN_REASON
+}
+
+type ExclusionAudit struct {
+	Candidates []*ExcludedCandidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Votes      []*ExcludedVote      `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
+	Pruned     bool                 `protobuf:"varint,3,opt,name=pruned,proto3" json:"pruned,omitempty"`
+	// the candidates registered with malformed addresses, which are kept in the result with the
+	// malformed addresses dropped
+	Malformed            []*Candidate `protobuf:"bytes,4,rep,name=malformed,proto3" json:"malformed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ExclusionAudit) Reset()         { *m = ExclusionAudit{} }
+func (m *ExclusionAudit) String() string { return proto.CompactTextString(m) }
+func (*ExclusionAudit) ProtoMessage()    {}
+func (*ExclusionAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{11}
+}
+
+func (m *ExclusionAudit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExclusionAudit.Unmarshal(m, b)
+}
+func (m *ExclusionAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExclusionAudit.Marshal(b, m, deterministic)
+}
+func (m *ExclusionAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExclusionAudit.Merge(m, src)
+}
+func (m *ExclusionAudit) XXX_Size() int {
+	return xxx_messageInfo_ExclusionAudit.Size(m)
+}
+func (m *ExclusionAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExclusionAudit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExclusionAudit proto.InternalMessageInfo
+
+func (m *ExclusionAudit) GetCandidates() []*ExcludedCandidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func (m *ExclusionAudit) GetVotes() []*ExcludedVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *ExclusionAudit) GetPruned() bool {
+	if m != nil {
+		return m.Pruned
+	}
+	return false
+}
+
+func (m *ExclusionAudit) GetMalformed() []*Candidate {
+	if m != nil {
+		return m.Malformed
+	}
+	return nil
+}
+
+type TopVoterShare struct {
+	Top                  uint32   `protobuf:"varint,1,opt,name=top,proto3" json:"top,omitempty"`
+	Share                float64  `protobuf:"fixed64,2,opt,name=share,proto3" json:"share,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TopVoterShare) Reset()         { *m = TopVoterShare{} }
+func (m *TopVoterShare) String() string { return proto.CompactTextString(m) }
+func (*TopVoterShare) ProtoMessage()    {}
+func (*TopVoterShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{12}
+}
+
+func (m *TopVoterShare) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopVoterShare.Unmarshal(m, b)
+}
+func (m *TopVoterShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopVoterShare.Marshal(b, m, deterministic)
+}
+func (m *TopVoterShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopVoterShare.Merge(m, src)
+}
+func (m *TopVoterShare) XXX_Size() int {
+	return xxx_messageInfo_TopVoterShare.Size(m)
+}
+func (m *TopVoterShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopVoterShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopVoterShare proto.InternalMessageInfo
+
+func (m *TopVoterShare) GetTop() uint32 {
+	if m != nil {
+		return m.Top
+	}
+	return 0
+}
+
+func (m *TopVoterShare) GetShare() float64 {
+	if m != nil {
+		return m.Share
+	}
+	return 0
+}
+
+type DelegateVoters struct {
+	Name                 []byte   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	UniqueVoters         uint32   `protobuf:"varint,2,opt,name=uniqueVoters,proto3" json:"uniqueVoters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelegateVoters) Reset()         { *m = DelegateVoters{} }
+func (m *DelegateVoters) String() string { return proto.CompactTextString(m) }
+func (*DelegateVoters) ProtoMessage()    {}
+func (*DelegateVoters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{13}
+}
+
+func (m *DelegateVoters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegateVoters.Unmarshal(m, b)
+}
+func (m *DelegateVoters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegateVoters.Marshal(b, m, deterministic)
+}
+func (m *DelegateVoters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateVoters.Merge(m, src)
+}
+func (m *DelegateVoters) XXX_Size() int {
+	return xxx_messageInfo_DelegateVoters.Size(m)
+}
+func (m *DelegateVoters) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateVoters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateVoters proto.InternalMessageInfo
+
+func (m *DelegateVoters) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *DelegateVoters) GetUniqueVoters() uint32 {
+	if m != nil {
+		return m.UniqueVoters
+	}
+	return 0
+}
+
+type Statistics struct {
+	NakamotoCoefficient  uint32            `protobuf:"varint,1,opt,name=nakamotoCoefficient,proto3" json:"nakamotoCoefficient,omitempty"`
+	VoterGini            float64           `protobuf:"fixed64,2,opt,name=voterGini,proto3" json:"voterGini,omitempty"`
+	TopVoterShares       []*TopVoterShare  `protobuf:"bytes,3,rep,name=topVoterShares,proto3" json:"topVoterShares,omitempty"`
+	DelegateVoters       []*DelegateVoters `protobuf:"bytes,4,rep,name=delegateVoters,proto3" json:"delegateVoters,omitempty"`
+	SelfStakingShare     float64           `protobuf:"fixed64,5,opt,name=selfStakingShare,proto3" json:"selfStakingShare,omitempty"`
+	TotalVoters          uint32            `protobuf:"varint,6,opt,name=totalVoters,proto3" json:"totalVoters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Statistics) Reset()         { *m = Statistics{} }
+func (m *Statistics) String() string { return proto.CompactTextString(m) }
+func (*Statistics) ProtoMessage()    {}
+func (*Statistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{14}
+}
+
+func (m *Statistics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Statistics.Unmarshal(m, b)
+}
+func (m *Statistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Statistics.Marshal(b, m, deterministic)
+}
+func (m *Statistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Statistics.Merge(m, src)
+}
+func (m *Statistics) XXX_Size() int {
+	return xxx_messageInfo_Statistics.Size(m)
+}
+func (m *Statistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_Statistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Statistics proto.InternalMessageInfo
+
+func (m *Statistics) GetNakamotoCoefficient() uint32 {
+	if m != nil {
+		return m.NakamotoCoefficient
+	}
+	return 0
+}
+
+func (m *Statistics) GetVoterGini() float64 {
+	if m != nil {
+		return m.VoterGini
+	}
+	return 0
+}
+
+func (m *Statistics) GetTopVoterShares() []*TopVoterShare {
+	if m != nil {
+		return m.TopVoterShares
+	}
+	return nil
+}
+
+func (m *Statistics) GetDelegateVoters() []*DelegateVoters {
+	if m != nil {
+		return m.DelegateVoters
+	}
+	return nil
+}
+
+func (m *Statistics) GetSelfStakingShare() float64 {
+	if m != nil {
+		return m.SelfStakingShare
+	}
+	return 0
+}
+
+func (m *Statistics) GetTotalVoters() uint32 {
+	if m != nil {
+		return m.TotalVoters
+	}
+	return 0
+}
+
+// Envelope wraps a record stored in db with the schema version it is written in
+type Envelope struct {
+	Version              uint32   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Envelope) Reset()         { *m = Envelope{} }
+func (m *Envelope) String() string { return proto.CompactTextString(m) }
+func (*Envelope) ProtoMessage()    {}
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{15}
+}
+
+func (m *Envelope) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Envelope.Unmarshal(m, b)
+}
+func (m *Envelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Envelope.Marshal(b, m, deterministic)
+}
+func (m *Envelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Envelope.Merge(m, src)
+}
+func (m *Envelope) XXX_Size() int {
+	return xxx_messageInfo_Envelope.Size(m)
+}
+func (m *Envelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_Envelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Envelope proto.InternalMessageInfo
+
+func (m *Envelope) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Envelope) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// ProbationList lists the delegates on probation in an epoch
+type ProbationList struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// the percentage of score reduction, in which 100 excludes the delegates
+	IntensityRate        uint32   `protobuf:"varint,2,opt,name=intensityRate,proto3" json:"intensityRate,omitempty"`
+	Delegates            [][]byte `protobuf:"bytes,3,rep,name=delegates,proto3" json:"delegates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProbationList) Reset()         { *m = ProbationList{} }
+func (m *ProbationList) String() string { return proto.CompactTextString(m) }
+func (*ProbationList) ProtoMessage()    {}
+func (*ProbationList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{16}
+}
+
+func (m *ProbationList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbationList.Unmarshal(m, b)
+}
+func (m *ProbationList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbationList.Marshal(b, m, deterministic)
+}
+func (m *ProbationList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbationList.Merge(m, src)
+}
+func (m *ProbationList) XXX_Size() int {
+	return xxx_messageInfo_ProbationList.Size(m)
+}
+func (m *ProbationList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbationList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbationList proto.InternalMessageInfo
+
+func (m *ProbationList) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ProbationList) GetIntensityRate() uint32 {
+	if m != nil {
+		return m.IntensityRate
+	}
+	return 0
+}
+
+func (m *ProbationList) GetDelegates() [][]byte {
+	if m != nil {
+		return m.Delegates
+	}
+	return nil
+}
+
+// Adjustment records the change of a delegate in an adjusted ranking
+type Adjustment struct {
+	Name     []byte `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RawRank  uint32 `protobuf:"varint,2,opt,name=rawRank,proto3" json:"rawRank,omitempty"`
+	RawScore []byte `protobuf:"bytes,3,opt,name=rawScore,proto3" json:"rawScore,omitempty"`
+	// 0 if excluded
+	Rank                 uint32   `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	Score                []byte   `protobuf:"bytes,5,opt,name=score,proto3" json:"score,omitempty"`
+	Excluded             bool     `protobuf:"varint,6,opt,name=excluded,proto3" json:"excluded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Adjustment) Reset()         { *m = Adjustment{} }
+func (m *Adjustment) String() string { return proto.CompactTextString(m) }
+func (*Adjustment) ProtoMessage()    {}
+func (*Adjustment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64dbf621b3c93457, []int{17}
+}
+
+func (m *Adjustment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Adjustment.Unmarshal(m, b)
+}
+func (m *Adjustment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Adjustment.Marshal(b, m, deterministic)
+}
+func (m *Adjustment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Adjustment.Merge(m, src)
+}
+func (m *Adjustment) XXX_Size() int {
+	return xxx_messageInfo_Adjustment.Size(m)
+}
+func (m *Adjustment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Adjustment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Adjustment proto.InternalMessageInfo
+
+func (m *Adjustment) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *Adjustment) GetRawRank() uint32 {
+	if m != nil {
+		return m.RawRank
+	}
+	return 0
+}
+
+func (m *Adjustment) GetRawScore() []byte {
+	if m != nil {
+		return m.RawScore
+	}
+	return nil
+}
+
+func (m *Adjustment) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *Adjustment) GetScore() []byte {
+	if m != nil {
+		return m.Score
+	}
+	return nil
+}
+
+func (m *Adjustment) GetExcluded() bool {
+	if m != nil {
+		return m.Excluded
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("election.ExclusionReason", ExclusionReason_name, ExclusionReason_value)
 	proto.RegisterType((*Vote)(nil), "election.Vote")
 	proto.RegisterType((*VoteList)(nil), "election.VoteList")
 	proto.RegisterType((*Candidate)(nil), "election.Candidate")
 	proto.RegisterType((*ElectionResult)(nil), "election.ElectionResult")
+	proto.RegisterType((*VoteRun)(nil), "election.VoteRun")
+	proto.RegisterType((*VoteListDelta)(nil), "election.VoteListDelta")
+	proto.RegisterType((*ResultDelta)(nil), "election.ResultDelta")
+	proto.RegisterType((*StoredResult)(nil), "election.StoredResult")
+	proto.RegisterType((*ResultCommitment)(nil), "election.ResultCommitment")
+	proto.RegisterType((*ExcludedCandidate)(nil), "election.ExcludedCandidate")
+	proto.RegisterType((*ExcludedVote)(nil), "election.ExcludedVote")
+	proto.RegisterType((*ExclusionAudit)(nil), "election.ExclusionAudit")
+	proto.RegisterType((*TopVoterShare)(nil), "election.TopVoterShare")
+	proto.RegisterType((*DelegateVoters)(nil), "election.DelegateVoters")
+	proto.RegisterType((*Statistics)(nil), "election.Statistics")
+	proto.RegisterType((*Envelope)(nil), "election.Envelope")
+	proto.RegisterType((*ProbationList)(nil), "election.ProbationList")
+	proto.RegisterType((*Adjustment)(nil), "election.Adjustment")
 }
 
-func init() { proto.RegisterFile("election.proto", fileDescriptor_election_b0a13835e5dd684b) }
-
-var fileDescriptor_election_b0a13835e5dd684b = []byte{
-	// 447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x86, 0xe5, 0x24, 0x4d, 0x93, 0x69, 0x1a, 0x60, 0x40, 0xc8, 0x44, 0xa8, 0x44, 0x51, 0x85,
-	0x2c, 0x84, 0x5c, 0x28, 0x42, 0xea, 0xb5, 0x02, 0x6e, 0x9c, 0x96, 0xa8, 0x9c, 0xb7, 0xd9, 0xa9,
-	0xb1, 0x6a, 0x7b, 0xa3, 0xdd, 0x0d, 0x11, 0x47, 0xc4, 0xcf, 0xe6, 0x82, 0x3c, 0xeb, 0xb5, 0x49,
-	0x72, 0xe8, 0x2d, 0xef, 0xc7, 0x46, 0x33, 0xcf, 0x18, 0xa6, 0x54, 0xd0, 0xca, 0xe5, 0xba, 0x4a,
-	0xd7, 0x46, 0x3b, 0x8d, 0xa3, 0xa0, 0x67, 0x67, 0x99, 0xd6, 0x59, 0x41, 0x17, 0xec, 0xdf, 0x6e,
-	0xee, 0x2e, 0xd4, 0xc6, 0xc8, 0xae, 0x39, 0x7b, 0xb5, 0x9f, 0xbb, 0xbc, 0x24, 0xeb, 0x64, 0xb9,
-	0xf6, 0x85, 0xc5, 0xef, 0x1e, 0x0c, 0x6e, 0xb4, 0x23, 0x7c, 0x06, 0x47, 0x3f, 0xb5, 0x23, 0x13,
-	0x47, 0xf3, 0x28, 0x99, 0x08, 0x2f, 0xf0, 0x25, 0x8c, 0x57, 0xb2, 0x52, 0xb9, 0x92, 0x8e, 0xe2,
-	0x1e, 0x27, 0x9d, 0x81, 0xcf, 0x61, 0x28, 0x4b, 0xbd, 0xa9, 0x5c, 0xdc, 0xe7, 0xa8, 0x51, 0xf8,
-	0x1a, 0xa6, 0x5b, 0xca, 0xb3, 0x1f, 0x8e, 0xd4, 0xb5, 0xcf, 0x07, 0x9c, 0xef, 0xb9, 0x78, 0x05,
-	0x63, 0xeb, 0xa4, 0x71, 0xcb, 0xbc, 0xa4, 0xf8, 0x68, 0x1e, 0x25, 0x27, 0x97, 0xb3, 0xd4, 0x4f,
-	0x9c, 0x86, 0x89, 0xd3, 0x65, 0x98, 0x58, 0x74, 0x65, 0xfc, 0x08, 0xa3, 0xb0, 0x69, 0x3c, 0xe4,
-	0x87, 0x2f, 0x0e, 0x1e, 0x7e, 0x6e, 0x0a, 0xa2, 0xad, 0xd6, 0x4b, 0x2a, 0x5a, 0xc9, 0x5f, 0xf1,
-	0xf1, 0x3c, 0x4a, 0x46, 0xc2, 0x8b, 0xc5, 0x3b, 0x18, 0xd5, 0x08, 0xbe, 0xe6, 0xd6, 0xe1, 0xb9,
-	0xc7, 0x60, 0xe3, 0x68, 0xde, 0x4f, 0x4e, 0x2e, 0xa7, 0x69, 0x8b, 0xbe, 0xae, 0x78, 0x2c, 0x76,
-	0xf1, 0x37, 0x82, 0xf1, 0xa7, 0x16, 0x03, 0xc2, 0xa0, 0x92, 0x25, 0x35, 0xe4, 0xf8, 0x37, 0xc6,
-	0x70, 0x2c, 0x95, 0x32, 0x64, 0x6d, 0x83, 0x2d, 0x48, 0x4c, 0xe0, 0x91, 0x5e, 0x93, 0x91, 0x4e,
-	0x9b, 0xeb, 0xa6, 0xe1, 0xe9, 0xed, 0xdb, 0x78, 0x0e, 0xa7, 0x86, 0xb6, 0xd2, 0xa8, 0xd0, 0xf3,
-	0x14, 0x77, 0x4d, 0x7c, 0x0b, 0x4f, 0x2c, 0x15, 0x77, 0xdf, 0x9c, 0xbc, 0xcf, 0xab, 0xec, 0x3b,
-	0x13, 0x66, 0x98, 0x03, 0x71, 0x18, 0xd4, 0x04, 0xec, 0x4a, 0x1b, 0x62, 0x6a, 0x13, 0xe1, 0xc5,
-	0xde, 0x7f, 0x2c, 0xf5, 0x3d, 0x55, 0x96, 0x19, 0x4d, 0xc4, 0x61, 0xb0, 0xf8, 0xd3, 0x83, 0xe9,
-	0x97, 0x06, 0x8b, 0x20, 0xbb, 0x29, 0xf8, 0x92, 0xed, 0x97, 0xc5, 0x1c, 0x1e, 0xb8, 0x64, 0x5b,
-	0xc6, 0xf7, 0x30, 0x56, 0x54, 0x50, 0x26, 0x6b, 0xe8, 0x3d, 0x86, 0xfe, 0xb4, 0x83, 0xde, 0x42,
-	0x16, 0x5d, 0x0b, 0xaf, 0xe0, 0x34, 0x88, 0x1b, 0xbe, 0x55, 0x9f, 0x9f, 0xe1, 0xee, 0xad, 0xea,
-	0x73, 0x8a, 0xdd, 0x22, 0xbe, 0x81, 0xc7, 0x4e, 0x3b, 0x59, 0xd4, 0x4a, 0xd5, 0x4b, 0x51, 0x80,
-	0x7a, 0xe0, 0xe3, 0x19, 0x40, 0xeb, 0x59, 0x06, 0x3a, 0x11, 0xff, 0x39, 0xb7, 0x43, 0xde, 0xeb,
-	0xc3, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x9d, 0x91, 0xf1, 0x9d, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("election.proto", fileDescriptor_64dbf621b3c93457) }
+
+var fileDescriptor_64dbf621b3c93457 = []byte{
+	// 1224 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcb, 0x6e, 0x1b, 0x37,
+	0x17, 0xfe, 0x47, 0x96, 0x6d, 0xf9, 0x58, 0x52, 0x6c, 0x26, 0x70, 0x26, 0xca, 0x0f, 0xd7, 0x18,
+	0xa4, 0x85, 0x11, 0x04, 0xce, 0xa5, 0x68, 0x1b, 0xa0, 0xe8, 0x45, 0xb0, 0x26, 0x89, 0x11, 0x57,
+	0x2e, 0x28, 0xe5, 0x82, 0x76, 0x61, 0xd0, 0x1a, 0xca, 0x99, 0x78, 0x34, 0x54, 0x39, 0x1c, 0xbb,
+	0xde, 0xb5, 0x8b, 0xbe, 0x45, 0x5f, 0xa1, 0xaf, 0xd1, 0x17, 0xe8, 0xa3, 0x74, 0xd9, 0x4d, 0xc1,
+	0xc3, 0xe1, 0xdc, 0xe4, 0xb4, 0x40, 0x81, 0x76, 0x37, 0xe7, 0x3b, 0x1f, 0x0f, 0x39, 0x1f, 0xcf,
+	0x85, 0xd0, 0xe5, 0x11, 0x9f, 0xa8, 0x50, 0xc4, 0x7b, 0x73, 0x29, 0x94, 0x20, 0x2d, 0x6b, 0xf7,
+	0xb6, 0x4f, 0x85, 0x38, 0x8d, 0xf8, 0x7d, 0xc4, 0x4f, 0xd2, 0xe9, 0xfd, 0x20, 0x95, 0xac, 0x60,
+	0xf6, 0xde, 0xab, 0xfb, 0x55, 0x38, 0xe3, 0x89, 0x62, 0xb3, 0xb9, 0x21, 0x78, 0x3f, 0x36, 0xa0,
+	0xf9, 0x52, 0x28, 0x4e, 0x6e, 0xc0, 0xf2, 0xb9, 0x50, 0x5c, 0xba, 0xce, 0x8e, 0xb3, 0xdb, 0xa6,
+	0xc6, 0x20, 0xff, 0x87, 0xb5, 0x09, 0x8b, 0x83, 0x30, 0x60, 0x8a, 0xbb, 0x0d, 0xf4, 0x14, 0x00,
+	0xd9, 0x82, 0x15, 0x36, 0x13, 0x69, 0xac, 0xdc, 0x25, 0x74, 0x65, 0x16, 0xf9, 0x00, 0xba, 0x17,
+	0x3c, 0x3c, 0x7d, 0xa3, 0x78, 0xd0, 0x37, 0xfe, 0x26, 0xfa, 0x6b, 0x28, 0x79, 0x0c, 0x6b, 0x89,
+	0x62, 0x52, 0x8d, 0xc3, 0x19, 0x77, 0x97, 0x77, 0x9c, 0xdd, 0xf5, 0x47, 0xbd, 0x3d, 0x73, 0xe2,
+	0x3d, 0x7b, 0xe2, 0xbd, 0xb1, 0x3d, 0x31, 0x2d, 0xc8, 0xe4, 0x23, 0x68, 0xd9, 0x3f, 0x75, 0x57,
+	0x70, 0xe1, 0xad, 0x85, 0x85, 0x83, 0x8c, 0x40, 0x73, 0xaa, 0xfe, 0xc9, 0x80, 0x4f, 0xd8, 0xa5,
+	0xbb, 0xba, 0xe3, 0xec, 0xb6, 0xa8, 0x31, 0xbc, 0x07, 0xd0, 0xd2, 0x12, 0x1c, 0x86, 0x89, 0x22,
+	0x77, 0x8c, 0x0c, 0x89, 0xeb, 0xec, 0x2c, 0xed, 0xae, 0x3f, 0xea, 0xee, 0xe5, 0xd2, 0x6b, 0x8a,
+	0x91, 0x25, 0xf1, 0xfe, 0x70, 0x60, 0x6d, 0x3f, 0x97, 0x81, 0x40, 0x33, 0x66, 0x33, 0x9e, 0x29,
+	0x87, 0xdf, 0xc4, 0x85, 0x55, 0x16, 0x04, 0x92, 0x27, 0x49, 0x26, 0x9b, 0x35, 0xc9, 0x2e, 0x5c,
+	0x13, 0x73, 0x2e, 0x99, 0x12, 0xb2, 0x9f, 0x31, 0x8c, 0x7a, 0x75, 0x98, 0xdc, 0x81, 0x8e, 0xe4,
+	0x17, 0x4c, 0x06, 0x96, 0x67, 0x54, 0xac, 0x82, 0xe4, 0x1e, 0x6c, 0x26, 0x3c, 0x9a, 0x8e, 0x14,
+	0x3b, 0x0b, 0xe3, 0xd3, 0x57, 0xa8, 0x30, 0x8a, 0xd9, 0xa4, 0x8b, 0x0e, 0xad, 0x40, 0x32, 0x11,
+	0x92, 0xa3, 0x6a, 0x6d, 0x6a, 0x8c, 0x5a, 0x8c, 0xb1, 0x38, 0xe3, 0x71, 0x82, 0x1a, 0xb5, 0xe9,
+	0xa2, 0xc3, 0xfb, 0xad, 0x01, 0x5d, 0x3f, 0x93, 0x85, 0xf2, 0x24, 0x8d, 0xf0, 0x26, 0xf3, 0xcc,
+	0x72, 0x9d, 0xbf, 0xbf, 0xc9, 0x9c, 0x4c, 0x1e, 0xc2, 0x5a, 0xc0, 0x23, 0x7e, 0xca, 0xb4, 0xe8,
+	0x0d, 0x14, 0xfd, 0x7a, 0x21, 0x7a, 0x2e, 0x32, 0x2d, 0x58, 0xe4, 0x31, 0x74, 0xac, 0xf1, 0x12,
+	0xef, 0x6a, 0x09, 0x97, 0x91, 0xea, 0x5d, 0xe9, 0xeb, 0xa4, 0x55, 0x22, 0xb9, 0x0b, 0x1b, 0x4a,
+	0x28, 0x16, 0x69, 0x2b, 0xd0, 0x3f, 0xc5, 0xad, 0xa8, 0x0b, 0x38, 0xd9, 0x06, 0xc8, 0xb1, 0x04,
+	0x05, 0x6d, 0xd3, 0x12, 0xa2, 0x93, 0x7f, 0x2e, 0xd3, 0x98, 0x07, 0x28, 0x65, 0x8b, 0x66, 0x16,
+	0xf9, 0x18, 0xd6, 0x59, 0xf0, 0x36, 0x4d, 0xd4, 0x8c, 0xc7, 0x4a, 0xab, 0xa8, 0xcf, 0x76, 0xa3,
+	0x38, 0x5b, 0x3f, 0x77, 0xd2, 0x32, 0xd1, 0xfb, 0x16, 0x56, 0x31, 0xc5, 0xd2, 0x58, 0x87, 0x16,
+	0xd3, 0x69, 0xc2, 0x15, 0x4a, 0xd9, 0xa1, 0x99, 0xa5, 0x2f, 0x6f, 0x82, 0xe5, 0xd4, 0x40, 0xd8,
+	0x18, 0xc4, 0x83, 0xa6, 0xce, 0x4a, 0xcc, 0xa2, 0xc5, 0x8c, 0x45, 0x9f, 0xf7, 0x83, 0x03, 0x1d,
+	0x2b, 0xca, 0x80, 0x47, 0x8a, 0x91, 0xf7, 0xa1, 0x29, 0xd3, 0xd8, 0xe6, 0xf9, 0x66, 0x6d, 0x55,
+	0x1a, 0x53, 0x74, 0x6b, 0x15, 0x24, 0xb7, 0x65, 0x8b, 0xf7, 0xd3, 0xa1, 0x25, 0x44, 0x67, 0x73,
+	0xb5, 0xa8, 0xcd, 0x6d, 0xb4, 0x69, 0x1d, 0xf6, 0x7e, 0x6a, 0xc0, 0xba, 0xc9, 0x16, 0x73, 0x80,
+	0xff, 0x34, 0x65, 0x3e, 0xbb, 0x3a, 0x65, 0x6e, 0x2e, 0xa6, 0x0c, 0x1e, 0xee, 0x5f, 0xcc, 0x1b,
+	0xef, 0x35, 0xb4, 0x47, 0x4a, 0x48, 0x1e, 0x64, 0xa5, 0x83, 0x3d, 0x29, 0x52, 0xcc, 0x75, 0x6c,
+	0x4f, 0xd2, 0xea, 0x6c, 0x03, 0x9c, 0xb0, 0x84, 0x3f, 0x33, 0xe5, 0xdc, 0xc0, 0x72, 0x2e, 0x21,
+	0xba, 0xe7, 0x04, 0x4c, 0xb1, 0xac, 0x75, 0xe0, 0xb7, 0xf7, 0x16, 0x36, 0x4c, 0xcc, 0x7d, 0x31,
+	0x9b, 0x85, 0x98, 0x56, 0x9a, 0x27, 0x85, 0x50, 0xb6, 0x37, 0xe9, 0x6f, 0xdd, 0x57, 0x72, 0x65,
+	0xa8, 0x10, 0x26, 0x7c, 0x9b, 0x56, 0x41, 0xdd, 0xfa, 0xcf, 0x45, 0x66, 0x64, 0xdb, 0x14, 0x80,
+	0x77, 0x09, 0x9b, 0xfe, 0xf7, 0x93, 0x28, 0x0d, 0x78, 0x50, 0x34, 0xc2, 0x87, 0xe5, 0x69, 0x61,
+	0xae, 0xf4, 0xea, 0x8b, 0x99, 0x94, 0x96, 0xac, 0x48, 0xce, 0x12, 0x11, 0xe3, 0x21, 0xba, 0x8f,
+	0x6e, 0x15, 0x7c, 0x8c, 0x9f, 0x60, 0x8f, 0xd1, 0x04, 0x9a, 0x11, 0x3d, 0x0e, 0x6d, 0xbb, 0x35,
+	0x4e, 0x2e, 0x9b, 0xff, 0xce, 0xbb, 0xf3, 0xff, 0x9f, 0x6c, 0xf3, 0xab, 0x03, 0xdd, 0xdc, 0xd7,
+	0x4f, 0x83, 0x50, 0x91, 0x4f, 0x01, 0xf2, 0x93, 0xdb, 0xca, 0xb9, 0x5d, 0x8b, 0x54, 0x16, 0x84,
+	0x96, 0xe8, 0xe4, 0x9e, 0x9d, 0x2c, 0x26, 0x63, 0xb7, 0x16, 0xd7, 0x95, 0x26, 0x4c, 0xa9, 0xbb,
+	0x2c, 0x55, 0xba, 0xcb, 0x43, 0x58, 0x9b, 0xb1, 0x68, 0x2a, 0xe4, 0x8c, 0x07, 0x6e, 0xf3, 0x2f,
+	0x72, 0x3f, 0x67, 0x79, 0x9f, 0x40, 0x67, 0x2c, 0xe6, 0x3a, 0xb8, 0x1c, 0xbd, 0x61, 0x92, 0x93,
+	0x0d, 0x58, 0x52, 0x62, 0x9e, 0xf5, 0x16, 0xfd, 0x89, 0x53, 0x41, 0xbb, 0x50, 0x1d, 0x87, 0x1a,
+	0xc3, 0x7b, 0x06, 0xdd, 0x41, 0xa9, 0x0c, 0x64, 0x72, 0xe5, 0xa4, 0xf3, 0xa0, 0x9d, 0xc6, 0xe1,
+	0x77, 0x69, 0xc6, 0xc9, 0x7a, 0x53, 0x05, 0xf3, 0x7e, 0x69, 0x00, 0x8c, 0x14, 0x53, 0x61, 0xa2,
+	0xc2, 0x49, 0x42, 0x1e, 0xc0, 0xf5, 0x98, 0x9d, 0xb1, 0x99, 0x50, 0x62, 0x5f, 0xf0, 0xe9, 0x34,
+	0x9c, 0x84, 0x3c, 0xb6, 0xcd, 0xee, 0x2a, 0x97, 0x4d, 0x46, 0xf9, 0x34, 0x8c, 0xc3, 0xec, 0x90,
+	0x05, 0x40, 0xbe, 0x80, 0xae, 0x2a, 0xff, 0xe1, 0x15, 0xe5, 0x5d, 0x51, 0x80, 0xd6, 0xe8, 0xe4,
+	0x4b, 0xe8, 0x06, 0x95, 0x3f, 0xcd, 0xa4, 0x75, 0x8b, 0x00, 0x55, 0x25, 0x68, 0x8d, 0xaf, 0x3b,
+	0x44, 0x69, 0x50, 0x62, 0x58, 0xac, 0x7d, 0x87, 0x2e, 0xe0, 0x64, 0x07, 0xd6, 0xf3, 0x7e, 0x20,
+	0x13, 0x1c, 0x1f, 0x1d, 0x5a, 0x86, 0xbc, 0xcf, 0xa1, 0xe5, 0xc7, 0xe7, 0x3c, 0x12, 0x73, 0x7c,
+	0x49, 0x9c, 0x73, 0xa9, 0x93, 0x30, 0x13, 0xc8, 0x9a, 0xda, 0x33, 0x67, 0x97, 0x91, 0x60, 0x81,
+	0x7d, 0x63, 0x64, 0xa6, 0x17, 0x42, 0xe7, 0x6b, 0x29, 0x4e, 0xf0, 0xd1, 0x83, 0xcf, 0x9a, 0x1b,
+	0xb0, 0xcc, 0xe7, 0x62, 0xf2, 0x06, 0x43, 0x34, 0xa9, 0x31, 0x74, 0x23, 0x08, 0x63, 0xc5, 0xe3,
+	0x24, 0x54, 0x97, 0xd4, 0xbe, 0xf0, 0x3a, 0xb4, 0x0a, 0x6a, 0xed, 0x8b, 0x76, 0x6b, 0x9a, 0x7b,
+	0x01, 0x78, 0x3f, 0x3b, 0x00, 0xc5, 0x48, 0x7b, 0xd7, 0x5b, 0x48, 0xb2, 0x0b, 0xca, 0xe2, 0xb3,
+	0x6c, 0x03, 0x6b, 0x92, 0x1e, 0xb4, 0x24, 0xbb, 0x18, 0xe1, 0x83, 0xc4, 0xb4, 0x98, 0xdc, 0xc6,
+	0xce, 0xa5, 0x97, 0x34, 0x71, 0x09, 0x7e, 0x17, 0xaf, 0x97, 0xe5, 0xf2, 0xeb, 0xa5, 0x07, 0x2d,
+	0x9e, 0x95, 0x50, 0x36, 0x8b, 0x73, 0xfb, 0xee, 0xef, 0x0e, 0x5c, 0xab, 0x55, 0x38, 0x21, 0xd0,
+	0x7d, 0x31, 0x7c, 0x3e, 0x3c, 0x7a, 0x35, 0x3c, 0xa6, 0x7e, 0x7f, 0x74, 0x34, 0xdc, 0xf8, 0x1f,
+	0xd9, 0x02, 0xf2, 0x55, 0x7f, 0x78, 0xf0, 0xe4, 0xc0, 0x1f, 0x1c, 0xef, 0xf7, 0x87, 0x83, 0x83,
+	0x41, 0x7f, 0xec, 0x6f, 0x38, 0x1a, 0x7f, 0x72, 0x70, 0x38, 0xf6, 0x69, 0x05, 0x6f, 0x90, 0x4d,
+	0xe8, 0xe4, 0xf8, 0xcb, 0xa3, 0xb1, 0xbf, 0xb1, 0x44, 0x6e, 0xc2, 0xf5, 0x6f, 0x7c, 0x7a, 0x54,
+	0xd0, 0x8c, 0xa3, 0x49, 0x7a, 0xb0, 0x65, 0xf7, 0xab, 0xf9, 0x96, 0xc9, 0x6d, 0xb8, 0xe9, 0xbf,
+	0xde, 0x3f, 0x7c, 0x31, 0xf0, 0x07, 0x75, 0xe7, 0x8a, 0x8e, 0x78, 0x78, 0xf4, 0xea, 0x78, 0xb4,
+	0x7f, 0x44, 0xfd, 0xd2, 0xee, 0xab, 0x64, 0x1b, 0x7a, 0xe8, 0xf0, 0x0f, 0x9f, 0x1c, 0x8f, 0xc6,
+	0xfd, 0xe7, 0x07, 0xc3, 0xa7, 0x25, 0x7f, 0xeb, 0x64, 0x05, 0x07, 0xe8, 0x87, 0x7f, 0x0e, 0x00,
+	0xff, 0x3d, 0x64, 0xda, 0x39, 0x0c, 0x00, 0x00,
 }
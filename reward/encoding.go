@@ -0,0 +1,121 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package reward
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// PayoutCSVHeader is the header of the CSV encoding of payouts
+var PayoutCSVHeader = []string{
+	"delegate",
+	"recipient",
+	"type",
+	"votes",
+	"amount",
+}
+
+const (
+	commissionPayout = "commission"
+	votePayout       = "vote"
+)
+
+type payoutJSON struct {
+	Delegate  string `json:"delegate"`
+	Recipient string `json:"recipient"`
+	Type      string `json:"type"`
+	Votes     string `json:"votes,omitempty"`
+	Amount    string `json:"amount"`
+}
+
+type delegateRewardJSON struct {
+	Name          string `json:"name"`
+	Score         string `json:"score"`
+	EligibleVotes string `json:"eligibleVotes"`
+	Reward        string `json:"reward"`
+	Commission    string `json:"commission"`
+}
+
+type distributionJSON struct {
+	MintTime  string                `json:"mintTime"`
+	Pool      string                `json:"pool"`
+	Remainder string                `json:"remainder"`
+	Delegates []*delegateRewardJSON `json:"delegates"`
+	Payouts   []*payoutJSON         `json:"payouts"`
+}
+
+func (p *Payout) toJSON() *payoutJSON {
+	pJSON := &payoutJSON{
+		Delegate:  hex.EncodeToString(p.Delegate),
+		Recipient: p.Recipient.String(),
+		Type:      votePayout,
+		Amount:    p.Amount.Text(10),
+	}
+	if p.Commission {
+		pJSON.Type = commissionPayout
+	} else {
+		pJSON.Votes = p.Votes.Text(10)
+	}
+	return pJSON
+}
+
+// MarshalJSON encodes the distribution into JSON, in which the names are hex strings, the recipients
+// are io1 addresses and the amounts are decimal strings
+func (d *Distribution) MarshalJSON() ([]byte, error) {
+	dJSON := &distributionJSON{
+		MintTime:  d.MintTime.UTC().Format(time.RFC3339Nano),
+		Pool:      d.Pool.Text(10),
+		Remainder: d.Remainder.Text(10),
+		Delegates: make([]*delegateRewardJSON, len(d.Delegates)),
+		Payouts:   make([]*payoutJSON, len(d.Payouts)),
+	}
+	for i, dr := range d.Delegates {
+		dJSON.Delegates[i] = &delegateRewardJSON{
+			Name:          hex.EncodeToString(dr.Name),
+			Score:         dr.Score.Text(10),
+			EligibleVotes: dr.EligibleVotes.Text(10),
+			Reward:        dr.Reward.Text(10),
+			Commission:    dr.Commission.Text(10),
+		}
+	}
+	for i, p := range d.Payouts {
+		dJSON.Payouts[i] = p.toJSON()
+	}
+
+	return json.Marshal(dJSON)
+}
+
+// WritePayoutsCSV writes the payouts of the distribution as CSV with PayoutCSVHeader as the first row
+func (d *Distribution) WritePayoutsCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(PayoutCSVHeader); err != nil {
+		return err
+	}
+	for _, p := range d.Payouts {
+		pJSON := p.toJSON()
+		if err := writer.Write([]string{
+			pJSON.Delegate,
+			pJSON.Recipient,
+			pJSON.Type,
+			pJSON.Votes,
+			pJSON.Amount,
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,282 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package reward
+
+import (
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-election/types"
+)
+
+// maxCommissionRate is the commission rate of 100%, in basis points
+const maxCommissionRate = uint64(10000)
+
+// Config defines the rules to distribute an epoch reward pool
+type Config struct {
+	// NumOfDelegates is the number of the top delegates sharing the pool, 0 for all delegates. The
+	// excluded delegates count, such that they do not make room for the next ones
+	NumOfDelegates uint32 `yaml:"numOfDelegates"`
+	// CommissionRate is the share of a delegate's reward kept by the delegate, in basis points
+	CommissionRate uint64 `yaml:"commissionRate"`
+	// DelegateCommissionRates overrides CommissionRate for the delegates of the hex names
+	DelegateCommissionRates map[string]uint64 `yaml:"delegateCommissionRates"`
+	// MinBucketAmount is the minimum amount of a bucket to share the reward, in decimal string
+	MinBucketAmount string `yaml:"minBucketAmount"`
+	// ExcludedDelegates are the hex names of the delegates not sharing the pool
+	ExcludedDelegates []string `yaml:"excludedDelegates"`
+	// ExcludedVoters are the hex or io1 addresses of the voters not sharing any reward
+	ExcludedVoters []string `yaml:"excludedVoters"`
+}
+
+// DelegateReward is the share of a delegate in the pool
+type DelegateReward struct {
+	Name []byte
+	// Score is the total weighted votes of the delegate, by which the pool is shared
+	Score *big.Int
+	// EligibleVotes is the weighted votes of the buckets sharing the reward of the delegate
+	EligibleVotes *big.Int
+	Reward        *big.Int
+	// Commission is the part of the reward kept by the delegate, including the rounding remainder
+	Commission *big.Int
+}
+
+// Payout is an amount paid to a recipient out of the reward of a delegate
+type Payout struct {
+	Delegate  []byte
+	Recipient address.Address
+	// Commission is true if the payout is the commission of the delegate
+	Commission bool
+	// Votes is the weighted votes of the buckets of the recipient, nil for commission
+	Votes  *big.Int
+	Amount *big.Int
+}
+
+// Distribution is the payout table of an epoch reward pool
+type Distribution struct {
+	MintTime  time.Time
+	Pool      *big.Int
+	Delegates []*DelegateReward
+	// Payouts are listed by delegate in rank order, each of which starts with the commission,
+	// followed by the voters in the order of their first buckets
+	Payouts []*Payout
+	// Remainder is the part of the pool left by rounding
+	Remainder *big.Int
+}
+
+// Calculator calculates the distribution of reward pools by a config
+type Calculator struct {
+	numOfDelegates    int
+	commissionRate    uint64
+	commissionRates   map[string]uint64
+	minBucketAmount   *big.Int
+	excludedDelegates map[string]bool
+	excludedVoters    map[string]bool
+}
+
+// NewCalculator creates a reward calculator with a config
+func NewCalculator(cfg Config) (*Calculator, error) {
+	calculator := &Calculator{
+		numOfDelegates:    int(cfg.NumOfDelegates),
+		commissionRate:    cfg.CommissionRate,
+		commissionRates:   map[string]uint64{},
+		minBucketAmount:   big.NewInt(0),
+		excludedDelegates: map[string]bool{},
+		excludedVoters:    map[string]bool{},
+	}
+	if cfg.CommissionRate > maxCommissionRate {
+		return nil, errors.Errorf("commission rate %d is larger than %d", cfg.CommissionRate, maxCommissionRate)
+	}
+	for name, rate := range cfg.DelegateCommissionRates {
+		if rate > maxCommissionRate {
+			return nil, errors.Errorf("commission rate %d of %s is larger than %d", rate, name, maxCommissionRate)
+		}
+		key, err := nameKey(name)
+		if err != nil {
+			return nil, err
+		}
+		calculator.commissionRates[key] = rate
+	}
+	if cfg.MinBucketAmount != "" {
+		amount, ok := new(big.Int).SetString(cfg.MinBucketAmount, 10)
+		if !ok || amount.Sign() < 0 {
+			return nil, errors.Errorf("invalid min bucket amount %s", cfg.MinBucketAmount)
+		}
+		calculator.minBucketAmount = amount
+	}
+	for _, name := range cfg.ExcludedDelegates {
+		key, err := nameKey(name)
+		if err != nil {
+			return nil, err
+		}
+		calculator.excludedDelegates[key] = true
+	}
+	for _, voter := range cfg.ExcludedVoters {
+		addr, err := types.DecodeAddress(voter)
+		if err != nil {
+			return nil, err
+		}
+		calculator.excludedVoters[hex.EncodeToString(addr)] = true
+	}
+
+	return calculator, nil
+}
+
+func nameKey(name string) (string, error) {
+	b, err := hex.DecodeString(name)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid delegate name %s", name)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Distribute shares the pool among the delegates of the result pro rata to their scores, and then the
+// reward of each delegate, after the commission, among the eligible buckets pro rata to their weighted
+// votes. A delegate without eligible buckets keeps its whole reward as commission.
+func (calculator *Calculator) Distribute(result *types.ElectionResult, pool *big.Int) (*Distribution, error) {
+	if result == nil {
+		return nil, errors.New("result is missing")
+	}
+	if result.Pruned() {
+		return nil, errors.New("cannot distribute reward by a pruned result")
+	}
+	if pool == nil || pool.Sign() < 0 {
+		return nil, errors.New("invalid reward pool")
+	}
+	delegates := []*types.Candidate{}
+	for i, d := range result.Delegates() {
+		if calculator.numOfDelegates > 0 && i == calculator.numOfDelegates {
+			break
+		}
+		if calculator.excludedDelegates[hex.EncodeToString(d.Name())] {
+			continue
+		}
+		delegates = append(delegates, d)
+	}
+	totalScore := big.NewInt(0)
+	for _, d := range delegates {
+		totalScore.Add(totalScore, d.Score())
+	}
+	distribution := &Distribution{
+		MintTime:  result.MintTime(),
+		Pool:      new(big.Int).Set(pool),
+		Delegates: make([]*DelegateReward, 0, len(delegates)),
+		Payouts:   []*Payout{},
+		Remainder: new(big.Int).Set(pool),
+	}
+	if totalScore.Sign() == 0 {
+		return distribution, nil
+	}
+	for _, d := range delegates {
+		reward := new(big.Int).Mul(pool, d.Score())
+		reward.Quo(reward, totalScore)
+		dr, payouts, err := calculator.distributeDelegateReward(d, result.VotesByDelegate(d.Name()), reward)
+		if err != nil {
+			return nil, err
+		}
+		distribution.Delegates = append(distribution.Delegates, dr)
+		distribution.Payouts = append(distribution.Payouts, payouts...)
+		distribution.Remainder.Sub(distribution.Remainder, reward)
+	}
+
+	return distribution, nil
+}
+
+func (calculator *Calculator) distributeDelegateReward(
+	delegate *types.Candidate,
+	votes []*types.Vote,
+	reward *big.Int,
+) (*DelegateReward, []*Payout, error) {
+	name := delegate.Name()
+	recipient, err := commissionRecipient(delegate)
+	if err != nil {
+		return nil, nil, err
+	}
+	rate, ok := calculator.commissionRates[hex.EncodeToString(name)]
+	if !ok {
+		rate = calculator.commissionRate
+	}
+	commission := new(big.Int).Mul(reward, new(big.Int).SetUint64(rate))
+	commission.Quo(commission, new(big.Int).SetUint64(maxCommissionRate))
+	shared := new(big.Int).Sub(reward, commission)
+
+	// aggregate the eligible buckets by voter
+	voters := []string{}
+	voterVotes := map[string]*big.Int{}
+	eligibleVotes := big.NewInt(0)
+	for _, v := range votes {
+		voter := hex.EncodeToString(v.Voter())
+		if calculator.excludedVoters[voter] || v.Amount().Cmp(calculator.minBucketAmount) < 0 {
+			continue
+		}
+		if _, ok := voterVotes[voter]; !ok {
+			voters = append(voters, voter)
+			voterVotes[voter] = big.NewInt(0)
+		}
+		voterVotes[voter].Add(voterVotes[voter], v.WeightedAmount())
+		eligibleVotes.Add(eligibleVotes, v.WeightedAmount())
+	}
+	payouts := []*Payout{}
+	if eligibleVotes.Sign() > 0 {
+		remainder := new(big.Int).Set(shared)
+		for _, voter := range voters {
+			amount := new(big.Int).Mul(shared, voterVotes[voter])
+			amount.Quo(amount, eligibleVotes)
+			addr, err := hex.DecodeString(voter)
+			if err != nil {
+				return nil, nil, err
+			}
+			ioAddr, err := types.IoAddress(addr)
+			if err != nil {
+				return nil, nil, err
+			}
+			payouts = append(payouts, &Payout{
+				Delegate:  name,
+				Recipient: ioAddr,
+				Votes:     voterVotes[voter],
+				Amount:    amount,
+			})
+			remainder.Sub(remainder, amount)
+		}
+		commission.Add(commission, remainder)
+	} else {
+		commission.Set(reward)
+	}
+
+	dr := &DelegateReward{
+		Name:          name,
+		Score:         delegate.Score(),
+		EligibleVotes: eligibleVotes,
+		Reward:        reward,
+		Commission:    commission,
+	}
+	payouts = append([]*Payout{{
+		Delegate:   name,
+		Recipient:  recipient,
+		Commission: true,
+		Amount:     commission,
+	}}, payouts...)
+
+	return dr, payouts, nil
+}
+
+// commissionRecipient returns the reward address of the delegate, or the io1 form of its gravity
+// chain address if the reward address is unregistered or malformed
+func commissionRecipient(delegate *types.Candidate) (address.Address, error) {
+	if addr := delegate.RewardIoAddress(); addr != nil {
+		return addr, nil
+	}
+	return delegate.IoAddress()
+}
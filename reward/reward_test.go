@@ -0,0 +1,165 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package reward
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-election/types"
+)
+
+func TestDistribute(t *testing.T) {
+	require := require.New(t)
+	now := time.Now()
+	addr := func(b byte) []byte {
+		return append(bytes.Repeat([]byte{0}, 19), b)
+	}
+	alice := []byte("alice0000000")
+	bob := []byte("bob000000000")
+	calculator := types.NewResultCalculator(
+		now,
+		false,
+		func(*types.Vote) bool { return false },
+		func(v *types.Vote, _ time.Time) *big.Int { return v.Amount() },
+		func(*types.Candidate) bool { return false },
+	)
+	require.NoError(calculator.AddCandidates([]*types.Candidate{
+		types.NewCandidate(alice, addr(1), nil, []byte("io1kfpsvefk74cqxd245j2h5t2pld2wtxzyg6tqrt"), 1),
+		types.NewCandidate(bob, addr(2), nil, nil, 1),
+	}))
+	for _, vote := range []struct {
+		voter     byte
+		amount    int64
+		candidate []byte
+	}{
+		{11, 400, alice},
+		{12, 100, alice},
+		{13, 300, bob},
+		{11, 200, alice},
+	} {
+		v, err := types.NewVote(now, 0, big.NewInt(vote.amount), big.NewInt(0), addr(vote.voter), vote.candidate, false)
+		require.NoError(err)
+		require.NoError(calculator.AddVotes([]*types.Vote{v}))
+	}
+	result, err := calculator.Calculate()
+	require.NoError(err)
+	ioAddress := func(b byte) string {
+		ioAddr, err := types.IoAddress(addr(b))
+		require.NoError(err)
+		return ioAddr.String()
+	}
+	type payout struct {
+		recipient  string
+		commission bool
+		amount     int64
+	}
+	requirePayouts := func(expected []payout, d *Distribution) {
+		require.Equal(len(expected), len(d.Payouts))
+		total := new(big.Int).Set(d.Remainder)
+		for i, p := range d.Payouts {
+			require.Equal(expected[i].recipient, p.Recipient.String(), "payout %d", i)
+			require.Equal(expected[i].commission, p.Commission, "payout %d", i)
+			require.Equal(expected[i].amount, p.Amount.Int64(), "payout %d", i)
+			total.Add(total, p.Amount)
+		}
+		require.Equal(0, total.Cmp(d.Pool))
+	}
+
+	t.Run("commission", func(t *testing.T) {
+		c, err := NewCalculator(Config{
+			CommissionRate:          1000,
+			DelegateCommissionRates: map[string]uint64{hex.EncodeToString(bob): 0},
+			MinBucketAmount:         "200",
+		})
+		require.NoError(err)
+		d, err := c.Distribute(result, big.NewInt(1001))
+		require.NoError(err)
+		// alice of 700 votes gets 700, bob of 300 votes gets 300, and 1 is left by rounding
+		require.Equal(int64(1), d.Remainder.Int64())
+		require.Equal(2, len(d.Delegates))
+		require.Equal(int64(700), d.Delegates[0].Reward.Int64())
+		require.Equal(int64(600), d.Delegates[0].EligibleVotes.Int64())
+		requirePayouts([]payout{
+			{"io1kfpsvefk74cqxd245j2h5t2pld2wtxzyg6tqrt", true, 70},
+			{ioAddress(11), false, 630},
+			{ioAddress(2), true, 0},
+			{ioAddress(13), false, 300},
+		}, d)
+	})
+
+	t.Run("exclusion", func(t *testing.T) {
+		c, err := NewCalculator(Config{
+			CommissionRate:    1000,
+			ExcludedDelegates: []string{hex.EncodeToString(bob)},
+			ExcludedVoters:    []string{ioAddress(12)},
+		})
+		require.NoError(err)
+		d, err := c.Distribute(result, big.NewInt(1000))
+		require.NoError(err)
+		requirePayouts([]payout{
+			{"io1kfpsvefk74cqxd245j2h5t2pld2wtxzyg6tqrt", true, 100},
+			{ioAddress(11), false, 900},
+		}, d)
+
+		c, err = NewCalculator(Config{NumOfDelegates: 1, ExcludedVoters: []string{hex.EncodeToString(addr(11))}, MinBucketAmount: "200"})
+		require.NoError(err)
+		d, err = c.Distribute(result, big.NewInt(1000))
+		require.NoError(err)
+		// alice keeps the whole reward without eligible buckets
+		requirePayouts([]payout{
+			{"io1kfpsvefk74cqxd245j2h5t2pld2wtxzyg6tqrt", true, 1000},
+		}, d)
+	})
+
+	t.Run("encoding", func(t *testing.T) {
+		c, err := NewCalculator(Config{CommissionRate: 1000})
+		require.NoError(err)
+		d, err := c.Distribute(result, big.NewInt(1000))
+		require.NoError(err)
+		data, err := json.Marshal(d)
+		require.NoError(err)
+		dJSON := &distributionJSON{}
+		require.NoError(json.Unmarshal(data, dJSON))
+		require.Equal("1000", dJSON.Pool)
+		require.Equal(hex.EncodeToString(alice), dJSON.Delegates[0].Name)
+		require.Equal(commissionPayout, dJSON.Payouts[0].Type)
+		require.Equal("600", dJSON.Payouts[1].Votes)
+
+		buf := new(bytes.Buffer)
+		require.NoError(d.WritePayoutsCSV(buf))
+		records, err := csv.NewReader(buf).ReadAll()
+		require.NoError(err)
+		require.Equal(len(d.Payouts)+1, len(records))
+		require.Equal(PayoutCSVHeader, records[0])
+		require.Equal([]string{hex.EncodeToString(alice), ioAddress(11), votePayout, "600", "540"}, records[2])
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := NewCalculator(Config{CommissionRate: 10001})
+		require.Error(err)
+		_, err = NewCalculator(Config{MinBucketAmount: "-1"})
+		require.Error(err)
+		_, err = NewCalculator(Config{ExcludedVoters: []string{"io1invalid"}})
+		require.Error(err)
+		c, err := NewCalculator(Config{})
+		require.NoError(err)
+		_, err = c.Distribute(result.Summary(), big.NewInt(1000))
+		require.Error(err)
+	})
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package rolldpos
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-election/types"
+)
+
+const (
+	defaultNumOfDelegates            = 36
+	defaultNumOfProducers            = 24
+	defaultNumOfSubEpochs            = 15
+	defaultDardanellesHeight         = 1816201
+	defaultNumOfSubEpochsDardanelles = 30
+)
+
+// cryptoSeed is the seed iotex-core mixes into the shuffle of block producers, i.e., crypto.CryptoSeed
+var cryptoSeed = []byte{0x12, 0x34, 0x56, 0x78, 0x90, 0xab, 0xcd, 0xef}
+
+// Config defines the parameters of the Roll-DPoS selection, which are the ones of the IoTeX mainnet
+// genesis if 0
+type Config struct {
+	// NumOfDelegates is the number of the consensus delegates, 36 if 0
+	NumOfDelegates uint32 `yaml:"numOfDelegates"`
+	// NumOfProducers is the number of the block producers of an epoch, 24 if 0
+	NumOfProducers uint32 `yaml:"numOfProducers"`
+	// NumOfSubEpochs is the number of the sub epochs of an epoch, 15 if 0
+	NumOfSubEpochs uint64 `yaml:"numOfSubEpochs"`
+	// DardanellesHeight is the IoTeX height since which the epochs have NumOfSubEpochsDardanelles sub
+	// epochs, 1816201 if 0
+	DardanellesHeight uint64 `yaml:"dardanellesHeight"`
+	// NumOfSubEpochsDardanelles is the number of the sub epochs of an epoch since DardanellesHeight,
+	// 30 if 0
+	NumOfSubEpochsDardanelles uint64 `yaml:"numOfSubEpochsDardanelles"`
+	// Seed is the hex string mixed into the shuffle of block producers, the seed of iotex-core if empty
+	Seed string `yaml:"seed"`
+}
+
+// Selector selects the consensus delegates from an election result, and the block producers of an
+// epoch from the consensus delegates, the same way as iotex-core
+type Selector struct {
+	numOfDelegates            int
+	numOfProducers            int
+	numOfSubEpochs            uint64
+	dardanellesHeight         uint64
+	numOfSubEpochsDardanelles uint64
+	seed                      []byte
+	filter                    types.CandidateFilterFunc
+}
+
+// NewSelector creates a selector with a config, and a filter returning true for the candidates which
+// are not qualified to be consensus delegates. A nil filter qualifies every candidate
+func NewSelector(cfg Config, filter types.CandidateFilterFunc) (*Selector, error) {
+	seed, err := hex.DecodeString(cfg.Seed)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid seed")
+	}
+	if len(seed) == 0 {
+		seed = cryptoSeed
+	}
+	s := &Selector{
+		numOfDelegates:            int(cfg.NumOfDelegates),
+		numOfProducers:            int(cfg.NumOfProducers),
+		numOfSubEpochs:            cfg.NumOfSubEpochs,
+		dardanellesHeight:         cfg.DardanellesHeight,
+		numOfSubEpochsDardanelles: cfg.NumOfSubEpochsDardanelles,
+		seed:                      seed,
+		filter:                    filter,
+	}
+	if s.numOfDelegates == 0 {
+		s.numOfDelegates = defaultNumOfDelegates
+	}
+	if s.numOfProducers == 0 {
+		s.numOfProducers = defaultNumOfProducers
+	}
+	if s.numOfSubEpochs == 0 {
+		s.numOfSubEpochs = defaultNumOfSubEpochs
+	}
+	if s.dardanellesHeight == 0 {
+		s.dardanellesHeight = defaultDardanellesHeight
+	}
+	if s.numOfSubEpochsDardanelles == 0 {
+		s.numOfSubEpochsDardanelles = defaultNumOfSubEpochsDardanelles
+	}
+	if s.numOfProducers > s.numOfDelegates {
+		return nil, errors.Errorf(
+			"number of producers %d is larger than number of delegates %d",
+			s.numOfProducers,
+			s.numOfDelegates,
+		)
+	}
+	return s, nil
+}
+
+// WithFilter returns a copy of the selector qualifying the candidates by another filter
+func (s *Selector) WithFilter(filter types.CandidateFilterFunc) *Selector {
+	clone := *s
+	clone.filter = filter
+	return &clone
+}
+
+// ConsensusDelegates returns the top qualified delegates of the result in rank order. Delegates without
+// a valid operator or reward address are skipped, as iotex-core does
+func (s *Selector) ConsensusDelegates(result *types.ElectionResult) []*types.Candidate {
+	delegates := []*types.Candidate{}
+	for _, d := range result.Delegates() {
+		if len(delegates) == s.numOfDelegates {
+			break
+		}
+		if d.OperatorIoAddress() == nil || d.RewardIoAddress() == nil {
+			continue
+		}
+		if s.filter != nil && s.filter(d) {
+			continue
+		}
+		delegates = append(delegates, d)
+	}
+	return delegates
+}
+
+// EpochHeight returns the IoTeX height at which an epoch starts, which seeds the shuffle of the epoch
+func (s *Selector) EpochHeight(epoch uint64) uint64 {
+	if epoch == 0 {
+		return 0
+	}
+	blocks := uint64(s.numOfProducers) * s.numOfSubEpochs
+	dardanellesEpoch := (s.dardanellesHeight-1)/blocks + 1
+	if epoch <= dardanellesEpoch {
+		return (epoch-1)*blocks + 1
+	}
+	return s.EpochHeight(dardanellesEpoch) +
+		(epoch-dardanellesEpoch)*uint64(s.numOfProducers)*s.numOfSubEpochsDardanelles
+}
+
+// BlockProducers shuffles the consensus delegates of the result by their operator addresses, the seed
+// and the height of the epoch, and returns the first ones in the order of producing blocks in the epoch
+func (s *Selector) BlockProducers(result *types.ElectionResult, epoch uint64) []*types.Candidate {
+	delegates := s.ConsensusDelegates(result)
+	operators := make([]string, len(delegates))
+	byOperator := make(map[string]*types.Candidate, len(delegates))
+	for i, d := range delegates {
+		operators[i] = d.OperatorIoAddress().String()
+		byOperator[operators[i]] = d
+	}
+	sortOperators(operators, s.EpochHeight(epoch), s.seed)
+	if len(operators) > s.numOfProducers {
+		operators = operators[:s.numOfProducers]
+	}
+	producers := make([]*types.Candidate, len(operators))
+	for i, operator := range operators {
+		producers[i] = byOperator[operator]
+	}
+	return producers
+}
+
+// sortOperators sorts the operator addresses by the hashes of the address, the seed and the little
+// endian epoch height, as crypto.SortCandidates of iotex-core
+func sortOperators(operators []string, epochHeight uint64, seed []byte) {
+	nb := make([]byte, 8)
+	binary.LittleEndian.PutUint64(nb, epochHeight)
+	sort.Slice(operators, func(i, j int) bool {
+		hi := hash.Hash256b(append(append([]byte(operators[i]), seed...), nb...))
+		hj := hash.Hash256b(append(append([]byte(operators[j]), seed...), nb...))
+		return bytes.Compare(hi[:], hj[:]) < 0
+	})
+}
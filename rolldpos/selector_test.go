@@ -0,0 +1,174 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package rolldpos
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-election/types"
+)
+
+func TestSelector(t *testing.T) {
+	require := require.New(t)
+	now := time.Now()
+	calculator := types.NewResultCalculator(
+		now,
+		false,
+		func(*types.Vote) bool { return false },
+		func(v *types.Vote, _ time.Time) *big.Int { return v.Amount() },
+		func(*types.Candidate) bool { return false },
+	)
+	candidates := []*types.Candidate{}
+	votes := []*types.Vote{}
+	for i := 0; i < 10; i++ {
+		name := []byte(fmt.Sprintf("delegate%04d", i))
+		operator := operatorAddress(i)
+		reward := operator
+		switch i {
+		case 2:
+			// a delegate without operator address
+			operator = nil
+		case 5:
+			// a delegate without reward address
+			reward = nil
+		}
+		candidates = append(candidates, types.NewCandidate(name, []byte{byte(i)}, operator, reward, 1))
+		v, err := types.NewVote(now, 0, big.NewInt(int64(100-i)), big.NewInt(0), []byte{byte(100 + i)}, name, false)
+		require.NoError(err)
+		votes = append(votes, v)
+	}
+	require.NoError(calculator.AddCandidates(candidates))
+	require.NoError(calculator.AddVotes(votes))
+	result, err := calculator.Calculate()
+	require.NoError(err)
+
+	_, err = NewSelector(Config{NumOfDelegates: 3, NumOfProducers: 4}, nil)
+	require.Error(err)
+	_, err = NewSelector(Config{Seed: "xyz"}, nil)
+	require.Error(err)
+
+	// delegate0003 is not qualified
+	filter := func(c *types.Candidate) bool {
+		return string(c.Name()) == "delegate0003"
+	}
+	selector, err := NewSelector(Config{NumOfDelegates: 6, NumOfProducers: 4}, filter)
+	require.NoError(err)
+	delegates := selector.ConsensusDelegates(result)
+	names := []string{}
+	for _, d := range delegates {
+		names = append(names, string(d.Name()))
+	}
+	require.Equal([]string{
+		"delegate0000",
+		"delegate0001",
+		"delegate0004",
+		"delegate0006",
+		"delegate0007",
+		"delegate0008",
+	}, names)
+	// a copy qualifying every candidate leaves the selector intact
+	unfiltered := selector.WithFilter(nil).ConsensusDelegates(result)
+	require.Equal("delegate0003", string(unfiltered[2].Name()))
+	require.Equal(delegates, selector.ConsensusDelegates(result))
+
+	producers := selector.BlockProducers(result, 1)
+	require.Equal(4, len(producers))
+	// deterministic for the same epoch and seed
+	require.Equal(producers, selector.BlockProducers(result, 1))
+	consensus := map[string]bool{}
+	for _, name := range names {
+		consensus[name] = true
+	}
+	for _, p := range producers {
+		require.True(consensus[string(p.Name())])
+	}
+	// shuffled differently across epochs and seeds
+	differs := func(other *Selector, epoch uint64) bool {
+		for e := epoch; e < epoch+10; e++ {
+			for i, p := range other.BlockProducers(result, e) {
+				if string(p.Name()) != string(selector.BlockProducers(result, e-epoch+1)[i].Name()) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	require.True(differs(selector, 2))
+	seeded, err := NewSelector(Config{NumOfDelegates: 6, NumOfProducers: 4, Seed: "01"}, filter)
+	require.NoError(err)
+	require.True(differs(seeded, 1))
+}
+
+// operatorAddress returns the io1 address of 0x0i000...
+func operatorAddress(i int) []byte {
+	b := make([]byte, 20)
+	b[0] = byte(i + 1)
+	addr, err := address.FromBytes(b)
+	if err != nil {
+		panic(err)
+	}
+	return []byte(addr.String())
+}
+
+func TestBlockProducersOfIoTeX(t *testing.T) {
+	require := require.New(t)
+	now := time.Now()
+	calculator := types.NewResultCalculator(
+		now,
+		false,
+		func(*types.Vote) bool { return false },
+		func(v *types.Vote, _ time.Time) *big.Int { return v.Amount() },
+		func(*types.Candidate) bool { return false },
+	)
+	candidates := []*types.Candidate{}
+	votes := []*types.Vote{}
+	for i := 0; i < 36; i++ {
+		name := []byte(fmt.Sprintf("delegate%04d", i))
+		candidates = append(candidates, types.NewCandidate(name, []byte{byte(i)}, operatorAddress(i), operatorAddress(i), 1))
+		v, err := types.NewVote(now, 0, big.NewInt(int64(100-i)), big.NewInt(0), []byte{byte(100 + i)}, name, false)
+		require.NoError(err)
+		votes = append(votes, v)
+	}
+	require.NoError(calculator.AddCandidates(candidates))
+	require.NoError(calculator.AddVotes(votes))
+	result, err := calculator.Calculate()
+	require.NoError(err)
+	require.Equal("io1qyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq5qzxra", result.Delegates()[0].OperatorIoAddress().String())
+
+	selector, err := NewSelector(Config{}, nil)
+	require.NoError(err)
+	// the epoch heights and the block producers of the mainnet parameters, as computed by
+	// rolldpos.Protocol and crypto.SortCandidates of iotex-core v0.10.0 for the delegates above
+	for _, test := range []struct {
+		epoch     uint64
+		height    uint64
+		producers []int
+	}{
+		{1, 1, []int{10, 23, 14, 21, 28, 11, 8, 6, 33, 1, 9, 19, 0, 15, 32, 29, 5, 25, 18, 30, 35, 13, 17, 31}},
+		{2, 361, []int{31, 28, 32, 3, 20, 13, 15, 26, 24, 27, 6, 17, 29, 33, 18, 25, 23, 12, 14, 1, 8, 4, 34, 16}},
+		{5046, 1816201, []int{24, 17, 16, 18, 32, 33, 6, 30, 20, 28, 9, 4, 26, 21, 15, 14, 11, 10, 35, 19, 31, 0, 8, 2}},
+		{5047, 1816921, []int{20, 9, 34, 25, 2, 1, 27, 7, 0, 24, 22, 14, 29, 13, 30, 33, 10, 35, 17, 8, 31, 11, 21, 12}},
+		{10000, 5383081, []int{20, 27, 18, 33, 29, 34, 13, 17, 35, 1, 26, 3, 4, 10, 19, 16, 14, 31, 21, 32, 25, 9, 0, 6}},
+	} {
+		require.Equal(test.height, selector.EpochHeight(test.epoch), "epoch %d", test.epoch)
+		producers := selector.BlockProducers(result, test.epoch)
+		require.Equal(len(test.producers), len(producers))
+		for i, p := range producers {
+			require.Equal(fmt.Sprintf("delegate%04d", test.producers[i]), string(p.Name()), "epoch %d", test.epoch)
+		}
+	}
+}
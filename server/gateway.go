@@ -0,0 +1,85 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/rs/cors"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/iotexproject/iotex-election/pb/api"
+)
+
+// GatewayConfig defines the config of the REST gateway, which translates HTTP/JSON requests into the
+// calls of the gRPC api
+type GatewayConfig struct {
+	// Port is the port to serve the gateway, which is disabled if 0
+	Port int `yaml:"port"`
+	// AllowedOrigins are the origins allowed by CORS, in which "*" allows any origin
+	AllowedOrigins []string `yaml:"allowedOrigins"`
+	// AllowedHeaders are the request headers allowed by CORS
+	AllowedHeaders []string `yaml:"allowedHeaders"`
+}
+
+// startGateway serves the REST gateway proxying to the gRPC port, along with the OpenAPI document at
+// /swagger.json
+func (s *server) startGateway(ctx context.Context) error {
+	if s.gateway.Port == 0 {
+		return nil
+	}
+	gwmux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}),
+	)
+	if err := api.RegisterAPIServiceHandlerFromEndpoint(
+		ctx,
+		gwmux,
+		"127.0.0.1:"+strconv.Itoa(s.port),
+		[]grpc.DialOption{grpc.WithInsecure()},
+	); err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/swagger.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(api.SwaggerJSON)); err != nil {
+			zap.L().Error("failed to write swagger", zap.Error(err))
+		}
+	})
+	mux.Handle("/", gwmux)
+	s.httpServer = &http.Server{
+		Addr: ":" + strconv.Itoa(s.gateway.Port),
+		Handler: cors.New(cors.Options{
+			AllowedOrigins: s.gateway.AllowedOrigins,
+			AllowedMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut},
+			AllowedHeaders: s.gateway.AllowedHeaders,
+		}).Handler(mux),
+	}
+	zap.L().Info("Listen to gateway port", zap.Int("port", s.gateway.Port))
+	go func() {
+		if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			zap.L().Fatal("Failed to serve gateway", zap.Error(err))
+		}
+	}()
+
+	return nil
+}
+
+func (s *server) stopGateway(ctx context.Context) error {
+	if s.httpServer == nil {
+		return nil
+	}
+	return s.httpServer.Shutdown(ctx)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package server
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+
+	"github.com/iotexproject/iotex-election/committee"
+	"github.com/iotexproject/iotex-election/pb/api"
+	"github.com/iotexproject/iotex-election/types"
+)
+
+// maxHistoryPoints is the maximum number of points in a candidate history
+const maxHistoryPoints = 1000
+
+// GetCandidateHistory returns the rank, the score, the self staking tokens, the number of voters and
+// the qualification of a candidate on every step-th synced height within a range
+func (s *server) GetCandidateHistory(ctx context.Context, request *api.GetCandidateHistoryRequest) (*api.CandidateHistoryResponse, error) {
+	name, err := hex.DecodeString(request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(name) == 0 {
+		return nil, errors.New("candidate name is required")
+	}
+	start, end, err := s.historyRange(request)
+	if err != nil {
+		return nil, err
+	}
+	step := int(request.Step)
+	if step == 0 {
+		step = 1
+	}
+	heights := []uint64{}
+	for i, height := range s.electionCommittee.HeightsSince(start, 0) {
+		if height > end {
+			break
+		}
+		if i%step != 0 {
+			continue
+		}
+		if len(heights) == maxHistoryPoints {
+			return nil, fmt.Errorf("more than %d points in the range, narrow it or increase the step", maxHistoryPoints)
+		}
+		heights = append(heights, height)
+	}
+	response := &api.CandidateHistoryResponse{
+		Name:   request.Name,
+		Points: make([]*api.CandidateHistoryPoint, len(heights)),
+	}
+	for i, height := range heights {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result, err := s.electionCommittee.SummaryByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		stats, err := s.electionCommittee.StatisticsByHeight(height)
+		switch err {
+		case nil:
+		case committee.ErrPruned:
+			// the votes were pruned before the statistics were stored
+			stats = nil
+		default:
+			return nil, err
+		}
+		if response.Points[i], err = s.historyPoint(height, result, stats, name); err != nil {
+			return nil, err
+		}
+	}
+
+	return response, nil
+}
+
+// historyRange resolves the range of a candidate history request to synced heights
+func (s *server) historyRange(request *api.GetCandidateHistoryRequest) (start uint64, end uint64, err error) {
+	if request.StartTime != nil && request.StartHeight != "" || request.EndTime != nil && request.EndHeight != "" {
+		return 0, 0, errors.New("either heights or times should be given for the range")
+	}
+	switch {
+	case request.StartTime != nil:
+		start, err = s.heightByTime(request.StartTime)
+	case request.StartHeight != "":
+		start, err = s.parseHeight(request.StartHeight)
+	default:
+		err = errors.New("start height or time is required")
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	switch {
+	case request.EndTime != nil:
+		end, err = s.heightByTime(request.EndTime)
+	case request.EndHeight != "":
+		end, err = s.parseHeight(request.EndHeight)
+	default:
+		end, err = s.parseHeight(latestHeight)
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	if start > end {
+		return 0, 0, errors.New("start of the range is after its end")
+	}
+	return start, end, nil
+}
+
+// historyPoint returns the point of a candidate in the summary of the result on height, taking the
+// number of voters from the statistics of the result, which is nil if they are unavailable
+func (s *server) historyPoint(
+	height uint64,
+	result *types.ElectionResult,
+	stats *types.Statistics,
+	name []byte,
+) (*api.CandidateHistoryPoint, error) {
+	mintTime, err := ptypes.TimestampProto(result.MintTime())
+	if err != nil {
+		return nil, err
+	}
+	point := &api.CandidateHistoryPoint{
+		Height:   strconv.FormatUint(height, 10),
+		MintTime: mintTime,
+		Pruned:   stats == nil,
+	}
+	for i, d := range result.Delegates() {
+		if !bytes.Equal(d.Name(), name) {
+			continue
+		}
+		point.Rank = uint32(i + 1)
+		point.Score = d.Score().Text(10)
+		point.SelfStakingTokens = d.SelfStakingTokens().Text(10)
+		point.Qualified = !s.unqualified(d)
+		break
+	}
+	if stats != nil {
+		for _, voters := range stats.DelegateVoters {
+			if bytes.Equal(voters.Name, name) {
+				point.VoterCount = voters.UniqueVoters
+				break
+			}
+		}
+	}
+	return point, nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-election/committee"
+	"github.com/iotexproject/iotex-election/pb/api"
+	"github.com/iotexproject/iotex-election/test/mock/mock_committee"
+	"github.com/iotexproject/iotex-election/types"
+)
+
+func TestGetCandidateHistory(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mintTime := time.Now().Add(-time.Hour)
+	calculator := types.NewResultCalculator(
+		mintTime,
+		false,
+		func(*types.Vote) bool { return false },
+		func(v *types.Vote, _ time.Time) *big.Int { return v.Amount() },
+		func(*types.Candidate) bool { return false },
+	)
+	alice := []byte("alice0000000")
+	bob := []byte("bob000000000")
+	require.NoError(calculator.AddCandidates([]*types.Candidate{
+		types.NewCandidate(alice, []byte("a"), nil, nil, 1),
+		types.NewCandidate(bob, []byte("b"), nil, nil, 1),
+	}))
+	vote := func(voter string, candidate []byte, amount int64) *types.Vote {
+		v, err := types.NewVote(mintTime, 0, big.NewInt(amount), big.NewInt(0), []byte(voter), candidate, false)
+		require.NoError(err)
+		return v
+	}
+	require.NoError(calculator.AddVotes([]*types.Vote{
+		vote("v1", alice, 100),
+		vote("v2", alice, 50),
+		vote("v2", alice, 50),
+		vote("v3", bob, 150),
+	}))
+	result, err := calculator.Calculate()
+	require.NoError(err)
+	stats, err := types.NewStatistics(result)
+	require.NoError(err)
+
+	c := mock_committee.NewMockCommittee(ctrl)
+	c.EXPECT().HeightAtOrBefore(uint64(100)).Return(uint64(100), nil).AnyTimes()
+	c.EXPECT().LatestHeight().Return(uint64(120)).AnyTimes()
+	c.EXPECT().HeightsSince(uint64(100), 0).Return([]uint64{100, 110, 120}).AnyTimes()
+	c.EXPECT().SummaryByHeight(gomock.Any()).Return(result.Summary(), nil).AnyTimes()
+	// the statistics of 120 are unavailable, while the ones of 110 survive the pruning of its votes
+	c.EXPECT().StatisticsByHeight(uint64(100)).Return(stats, nil).AnyTimes()
+	c.EXPECT().StatisticsByHeight(uint64(110)).Return(stats, nil).AnyTimes()
+	c.EXPECT().StatisticsByHeight(uint64(120)).Return(nil, committee.ErrPruned).AnyTimes()
+	s := &server{
+		electionCommittee:    c,
+		scoreThreshold:       big.NewInt(180),
+		selfStakingThreshold: big.NewInt(0),
+	}
+
+	_, err = s.GetCandidateHistory(context.Background(), &api.GetCandidateHistoryRequest{StartHeight: "100"})
+	require.Error(err)
+	startTime, err := ptypes.TimestampProto(mintTime)
+	require.NoError(err)
+	_, err = s.GetCandidateHistory(context.Background(), &api.GetCandidateHistoryRequest{
+		Name:        hex.EncodeToString(alice),
+		StartHeight: "100",
+		StartTime:   startTime,
+	})
+	require.Error(err)
+
+	response, err := s.GetCandidateHistory(context.Background(), &api.GetCandidateHistoryRequest{
+		Name:        hex.EncodeToString(alice),
+		StartHeight: "100",
+	})
+	require.NoError(err)
+	require.Equal(3, len(response.Points))
+	for i, point := range response.Points {
+		require.Equal([]string{"100", "110", "120"}[i], point.Height)
+		require.Equal(uint32(1), point.Rank)
+		require.Equal("200", point.Score)
+		require.True(point.Qualified)
+	}
+	require.Equal(uint32(2), response.Points[0].VoterCount)
+	require.False(response.Points[0].Pruned)
+	require.Equal(uint32(2), response.Points[1].VoterCount)
+	require.Equal(uint32(0), response.Points[2].VoterCount)
+	require.True(response.Points[2].Pruned)
+
+	response, err = s.GetCandidateHistory(context.Background(), &api.GetCandidateHistoryRequest{
+		Name:        hex.EncodeToString(bob),
+		StartHeight: "100",
+		Step:        2,
+	})
+	require.NoError(err)
+	require.Equal(2, len(response.Points))
+	require.Equal("120", response.Points[1].Height)
+	require.Equal(uint32(2), response.Points[0].Rank)
+	require.Equal(uint32(1), response.Points[0].VoterCount)
+	require.False(response.Points[0].Qualified)
+}
@@ -11,16 +11,23 @@
 package server
 
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"log"
 	"math"
 	"math/big"
 	"net"
+	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/net/context"
@@ -30,20 +37,24 @@ import (
 	"github.com/iotexproject/iotex-election/committee"
 	"github.com/iotexproject/iotex-election/db"
 	"github.com/iotexproject/iotex-election/pb/api"
+	"github.com/iotexproject/iotex-election/rolldpos"
 	"github.com/iotexproject/iotex-election/types"
-	"github.com/iotexproject/iotex-election/util"
 	"github.com/iotexproject/iotex-election/votesync"
 )
 
 // Config defines the config for server
 type Config struct {
-	DB                   db.Config        `yaml:"db"`
-	Port                 int              `yaml:"port"`
-	Committee            committee.Config `yaml:"committee"`
-	SelfStakingThreshold string           `yaml:"selfStakingThreshold"`
-	ScoreThreshold       string           `yaml:"scoreThreshold"`
-	EnableVoteSync       bool             `yaml:"enableVoteSync"`
-	VoteSync             votesync.Config  `yaml:"voteSync"`
+	DB                      db.Config        `yaml:"db"`
+	Port                    int              `yaml:"port"`
+	Committee               committee.Config `yaml:"committee"`
+	SelfStakingThreshold    string           `yaml:"selfStakingThreshold"`
+	ScoreThreshold          string           `yaml:"scoreThreshold"`
+	EnableVoteSync          bool             `yaml:"enableVoteSync"`
+	VoteSync                votesync.Config  `yaml:"voteSync"`
+	RollDPoS                rolldpos.Config  `yaml:"rollDPoS"`
+	EnableProbationUpdate   bool             `yaml:"enableProbationUpdate"` // lets any caller of the gRPC port rewrite the probation lists
+	Gateway                 GatewayConfig    `yaml:"gateway"`
+	StreamHeartbeatInterval time.Duration    `yaml:"streamHeartbeatInterval"`
 }
 
 // Server defines the interface of the ranking server implementation
@@ -53,14 +64,22 @@ type Server interface {
 	Stop(context.Context) error
 }
 
+// latestHeight is the alias of the latest synced height accepted in the height fields of requests
+const latestHeight = "latest"
+
 // server implements api.APIServiceServer.
 type server struct {
 	port                 int
 	electionCommittee    committee.Committee
 	grpcServer           *grpc.Server
+	gateway              GatewayConfig
+	httpServer           *http.Server
+	heartbeatInterval    time.Duration
 	selfStakingThreshold *big.Int
 	scoreThreshold       *big.Int
 	voteSync             *votesync.VoteSync
+	selector             *rolldpos.Selector
+	probationUpdate      bool
 }
 
 // NewServer returns an implementation of ranking server
@@ -75,8 +94,15 @@ func NewServer(cfg *Config) (Server, error) {
 	zap.ReplaceGlobals(l)
 
 	var c committee.Committee
-	if cfg.DB.DBPath != "" {
-		c, err = committee.NewCommitteeWithKVStoreWithNamespace(db.NewBoltDB(cfg.DB), cfg.Committee)
+	dbCfg := cfg.DB
+	if cfg.Committee.Replica.ReadOnly {
+		if dbCfg.DBPath == "" {
+			return nil, errors.New("db path is required by a read-only committee")
+		}
+		dbCfg.ReadOnly = true
+	}
+	if dbCfg.DBPath != "" {
+		c, err = committee.NewCommitteeWithKVStoreWithNamespace(db.NewBoltDB(dbCfg), cfg.Committee)
 	} else {
 		c, err = committee.NewCommittee(db.NewInMemKVStore(), cfg.Committee)
 	}
@@ -105,6 +131,12 @@ func NewServer(cfg *Config) (Server, error) {
 		scoreThreshold:       scoreThreshold,
 		selfStakingThreshold: selfStakingThreshold,
 		voteSync:             vs,
+		probationUpdate:      cfg.EnableProbationUpdate,
+		gateway:              cfg.Gateway,
+		heartbeatInterval:    cfg.StreamHeartbeatInterval,
+	}
+	if s.selector, err = rolldpos.NewSelector(cfg.RollDPoS, s.unqualified); err != nil {
+		return nil, err
 	}
 	s.grpcServer = grpc.NewServer()
 	api.RegisterAPIServiceServer(s.grpcServer, s)
@@ -127,6 +159,9 @@ func (s *server) Start(ctx context.Context) error {
 			zap.L().Fatal("Failed to serve", zap.Error(err))
 		}
 	}()
+	if err := s.startGateway(ctx); err != nil {
+		return err
+	}
 	if err := s.electionCommittee.Start(ctx); err != nil {
 		return err
 	}
@@ -137,6 +172,9 @@ func (s *server) Start(ctx context.Context) error {
 }
 
 func (s *server) Stop(ctx context.Context) error {
+	if err := s.stopGateway(ctx); err != nil {
+		zap.L().Error("failed to stop gateway", zap.Error(err))
+	}
 	s.grpcServer.Stop()
 	if s.voteSync != nil {
 		s.voteSync.Stop(ctx)
@@ -147,13 +185,13 @@ func (s *server) Stop(ctx context.Context) error {
 // GetMeta returns the meta of the chain
 func (s *server) GetMeta(ctx context.Context, empty *empty.Empty) (*api.ChainMeta, error) {
 	height := s.electionCommittee.LatestHeight()
-	result, err := s.electionCommittee.ResultByHeight(height)
+	result, err := s.electionCommittee.SummaryByHeight(height)
 	if err != nil {
 		return &api.ChainMeta{}, err
 	}
 	numOfCandidates := uint64(0)
 	for _, d := range result.Delegates() {
-		if d.Score().Cmp(s.scoreThreshold) >= 0 && d.SelfStakingTokens().Cmp(s.selfStakingThreshold) >= 0 {
+		if !s.unqualified(d) {
 			numOfCandidates++
 		}
 	}
@@ -166,28 +204,124 @@ func (s *server) GetMeta(ctx context.Context, empty *empty.Empty) (*api.ChainMet
 	}, nil
 }
 
+// GetHeightByTime returns the nearest height with result before a time
+func (s *server) GetHeightByTime(ctx context.Context, request *api.GetHeightByTimeRequest) (*api.HeightResponse, error) {
+	height, err := s.heightByTime(request.Time)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.electionCommittee.SummaryByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	mintTime, err := ptypes.TimestampProto(result.MintTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.HeightResponse{
+		Height:   strconv.FormatUint(height, 10),
+		MintTime: mintTime,
+	}, nil
+}
+
+// GetResultByTime returns the summary of the nearest result before a time
+func (s *server) GetResultByTime(ctx context.Context, request *api.GetResultByTimeRequest) (*api.ResultSummary, error) {
+	height, err := s.heightByTime(request.Time)
+	if err != nil {
+		return nil, err
+	}
+	top := int(request.TopDelegates)
+	if top == 0 {
+		top = defaultTopDelegates
+	}
+
+	return s.resultSummary(height, top)
+}
+
+func (s *server) heightByTime(ts *timestamp.Timestamp) (uint64, error) {
+	if ts == nil {
+		return 0, errors.New("time is required")
+	}
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return 0, err
+	}
+	return s.electionCommittee.HeightByTime(t)
+}
+
+// parseHeight resolves the height of a request, which is either "latest" or a gravity chain height
+// within the synced range, to the nearest height with result at or before it
+func (s *server) parseHeight(h string) (uint64, error) {
+	if strings.EqualFold(h, latestHeight) {
+		height := s.electionCommittee.LatestHeight()
+		if height == 0 {
+			return 0, errors.New("no height has been synced yet")
+		}
+		return height, nil
+	}
+	height, err := strconv.ParseUint(h, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return s.electionCommittee.HeightAtOrBefore(height)
+}
+
+// unqualified returns true if the candidate is below the score or self staking threshold
+func (s *server) unqualified(c *types.Candidate) bool {
+	return c.Score().Cmp(s.scoreThreshold) < 0 || c.SelfStakingTokens().Cmp(s.selfStakingThreshold) < 0
+}
+
 func (s *server) IsHealth(ctx context.Context, empty *empty.Empty) (*api.HealthCheckResponse, error) {
-	var status api.HealthCheckResponse_Status
-	switch s.electionCommittee.Status() {
-	case committee.STARTING:
-		status = api.HealthCheckResponse_STARTING
-	case committee.ACTIVE:
-		status = api.HealthCheckResponse_ACTIVE
-	case committee.INACTIVE:
-		status = api.HealthCheckResponse_INACTIVE
+	failures, err := toConsistencyFailures(s.electionCommittee.Failures())
+	if err != nil {
+		return nil, err
 	}
 	return &api.HealthCheckResponse{
-		Status: status,
+		Status:   toHealthStatus(s.electionCommittee.Status()),
+		Failures: failures,
+	}, nil
+}
+
+// GetStatus returns the detailed status of syncing
+func (s *server) GetStatus(ctx context.Context, empty *empty.Empty) (*api.SyncStatus, error) {
+	status := s.electionCommittee.SyncStatus()
+	failures, err := toConsistencyFailures(status.Failures)
+	if err != nil {
+		return nil, err
+	}
+	tipTime, err := ptypes.TimestampProto(status.TipTime)
+	if err != nil {
+		return nil, err
+	}
+	lastSyncedTime, err := ptypes.TimestampProto(status.LastSyncedTime)
+	if err != nil {
+		return nil, err
+	}
+	return &api.SyncStatus{
+		Status:              toHealthStatus(status.Status),
+		TipHeight:           strconv.FormatUint(status.TipHeight, 10),
+		TipTime:             tipTime,
+		LastSyncedHeight:    strconv.FormatUint(status.LastSyncedHeight, 10),
+		LastSyncedTime:      lastSyncedTime,
+		LagHeights:          status.LagHeights,
+		LagDuration:         ptypes.DurationProto(status.LagDuration),
+		PendingHeights:      status.PendingHeights,
+		Failures:            failures,
+		Endpoint:            status.Endpoint,
+		CatchUpStartHeight:  strconv.FormatUint(status.CatchUpStartHeight, 10),
+		CatchUpTargetHeight: strconv.FormatUint(status.CatchUpTargetHeight, 10),
+		CatchUpProgress:     status.CatchUpProgress,
 	}, nil
 }
 
 // GetCandidates returns a list of candidates sorted by weighted votes
 func (s *server) GetCandidates(ctx context.Context, request *api.GetCandidatesRequest) (*api.CandidateResponse, error) {
-	height, err := strconv.ParseUint(request.Height, 10, 64)
+	height, err := s.parseHeight(request.Height)
 	if err != nil {
 		return nil, err
 	}
-	result, err := s.electionCommittee.ResultByHeight(height)
+	result, err := s.electionCommittee.SummaryByHeight(height)
 	if err != nil {
 		return nil, err
 	}
@@ -205,42 +339,43 @@ func (s *server) GetCandidates(ctx context.Context, request *api.GetCandidatesRe
 		limit = uint32(len(candidates)) - offset
 	}
 	response := &api.CandidateResponse{
+		Height:     strconv.FormatUint(height, 10),
 		Candidates: make([]*api.Candidate, limit),
 	}
 	for i := uint32(0); i < limit; i++ {
-		candidate := candidates[offset+i]
-		var ra string
-		var oa string
-		if util.IsAllZeros(candidate.RewardAddress()) {
-			ra = ""
-		} else {
-			ra = string(candidate.RewardAddress())
-		}
-		if util.IsAllZeros(candidate.OperatorAddress()) {
-			oa = ""
-		} else {
-			oa = string(candidate.OperatorAddress())
-		}
-		response.Candidates[i] = &api.Candidate{
-			Name:               hex.EncodeToString(candidate.Name()),
-			Address:            hex.EncodeToString(candidate.Address()),
-			RewardAddress:      ra,
-			OperatorAddress:    oa,
-			TotalWeightedVotes: candidate.Score().Text(10),
-			SelfStakingTokens:  candidate.SelfStakingTokens().Text(10),
-		}
+		response.Candidates[i] = toCandidate(candidates[offset+i])
 	}
 
 	return response, nil
 }
 
+func toCandidate(candidate *types.Candidate) *api.Candidate {
+	c := &api.Candidate{
+		Name:               hex.EncodeToString(candidate.Name()),
+		Address:            hex.EncodeToString(candidate.Address()),
+		MalformedAddress:   candidate.MalformedAddresses() != nil,
+		TotalWeightedVotes: candidate.Score().Text(10),
+		SelfStakingTokens:  candidate.SelfStakingTokens().Text(10),
+	}
+	if addr, err := candidate.IoAddress(); err == nil {
+		c.IoAddress = addr.String()
+	}
+	if addr := candidate.OperatorIoAddress(); addr != nil {
+		c.OperatorAddress = addr.String()
+	}
+	if addr := candidate.RewardIoAddress(); addr != nil {
+		c.RewardAddress = addr.String()
+	}
+	return c
+}
+
 // GetCandidateByName returns the candidate details
 func (s *server) GetCandidateByName(ctx context.Context, request *api.GetCandidateByNameRequest) (*api.Candidate, error) {
-	height, err := strconv.ParseUint(request.Height, 10, 64)
+	height, err := s.parseHeight(request.Height)
 	if err != nil {
 		return nil, err
 	}
-	result, err := s.electionCommittee.ResultByHeight(height)
+	result, err := s.electionCommittee.SummaryByHeight(height)
 	if err != nil {
 		return nil, err
 	}
@@ -252,76 +387,679 @@ func (s *server) GetCandidateByName(ctx context.Context, request *api.GetCandida
 	if candidate == nil {
 		return nil, errors.New("Cannot find candidate details")
 	}
-	return &api.Candidate{
-		Address:            hex.EncodeToString(candidate.Address()),
-		Name:               request.Name,
-		OperatorAddress:    string(candidate.OperatorAddress()),
-		RewardAddress:      string(candidate.RewardAddress()),
-		TotalWeightedVotes: candidate.Score().String(),
-		SelfStakingTokens:  candidate.SelfStakingTokens().String(),
-	}, nil
+	response := toCandidate(candidate)
+	response.Height = strconv.FormatUint(height, 10)
+
+	return response, nil
 }
 
 // GetBucketsByCandidate returns the buckets
 func (s *server) GetBucketsByCandidate(ctx context.Context, request *api.GetBucketsByCandidateRequest) (*api.BucketResponse, error) {
-	height, err := strconv.ParseUint(request.Height, 10, 64)
+	height, err := s.parseHeight(request.Height)
 	if err != nil {
 		return nil, err
 	}
-	result, err := s.electionCommittee.ResultByHeight(height)
+	name, err := hex.DecodeString(request.Name)
 	if err != nil {
 		return nil, err
 	}
-	name, err := hex.DecodeString(request.Name)
+	if len(name) != 0 && len(name) != 12 {
+		return nil, errors.New("invalid candidate name")
+	}
+
+	return s.listBuckets(
+		&bucketCursor{
+			height:     height,
+			name:       hex.EncodeToString(name),
+			sortBy:     request.SortBy,
+			descending: request.Descending,
+			offset:     request.Offset,
+		},
+		request.Cursor,
+		request.Limit,
+	)
+}
+
+// GetBuckets returns a list of buckets
+func (s *server) GetBuckets(ctx context.Context, request *api.GetBucketsRequest) (*api.BucketResponse, error) {
+	height, err := s.parseHeight(request.Height)
 	if err != nil {
 		return nil, err
 	}
-	var votes []*types.Vote
-	switch len(name) {
-		case 0:
-			votes = result.Votes()
-		case 12:
-			votes = result.VotesByDelegate(name)
-		default:
-			return nil, errors.New("invalid candidate name")
+
+	return s.listBuckets(
+		&bucketCursor{
+			height:     height,
+			sortBy:     request.SortBy,
+			descending: request.Descending,
+			offset:     request.Offset,
+		},
+		request.Cursor,
+		request.Limit,
+	)
+}
+
+// bucketCursor identifies a page of a bucket listing
+type bucketCursor struct {
+	height     uint64
+	name       string
+	sortBy     api.BucketSortKey
+	descending bool
+	offset     uint32
+}
+
+func (c *bucketCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(
+		"%d:%s:%d:%t:%d",
+		c.height,
+		c.name,
+		c.sortBy,
+		c.descending,
+		c.offset,
+	)))
+}
+
+func (c *bucketCursor) sameListing(cursor *bucketCursor) bool {
+	return c.height == cursor.height &&
+		c.name == cursor.name &&
+		c.sortBy == cursor.sortBy &&
+		c.descending == cursor.descending
+}
+
+func parseBucketCursor(cursor string) (*bucketCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
 	}
-	if votes == nil {
-		return nil, errors.New("No buckets for the candidate")
+	fields := strings.Split(string(data), ":")
+	if len(fields) != 5 {
+		return nil, errors.New("invalid cursor")
 	}
-	offset := request.Offset
-	if int(offset) >= len(votes) {
-		return nil, errors.New("offset is out of range")
+	height, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	sortBy, err := strconv.ParseInt(fields[2], 10, 32)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	descending, err := strconv.ParseBool(fields[3])
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	offset, err := strconv.ParseUint(fields[4], 10, 32)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
 	}
 
-	return s.toBucketResponse(votes, offset, request.Limit, result.MintTime()), nil
+	return &bucketCursor{
+		height:     height,
+		name:       fields[1],
+		sortBy:     api.BucketSortKey(sortBy),
+		descending: descending,
+		offset:     uint32(offset),
+	}, nil
 }
 
-func (s *server) toBucketResponse(votes []*types.Vote, offset uint32, limit uint32, mintTime time.Time) *api.BucketResponse {
-	// If limit is missing, return all buckets with indices starting from the offset
-	if limit == uint32(0) {
-		limit = math.MaxUint32
+func toVoteSortKey(key api.BucketSortKey) (types.VoteSortKey, error) {
+	switch key {
+	case api.BucketSortKey_CANONICAL:
+		return types.CanonicalOrder, nil
+	case api.BucketSortKey_AMOUNT:
+		return types.ByAmount, nil
+	case api.BucketSortKey_WEIGHTED_AMOUNT:
+		return types.ByWeightedAmount, nil
+	case api.BucketSortKey_REMAINING_DURATION:
+		return types.ByRemainingDuration, nil
+	case api.BucketSortKey_START_TIME:
+		return types.ByStartTime, nil
+	case api.BucketSortKey_VOTER:
+		return types.ByVoter, nil
+	default:
+		return 0, errors.New("invalid sort key")
 	}
-	if int(offset+limit) >= len(votes) {
+}
+
+// listBuckets returns a page of the buckets sorted as requested, starting from the offset of the
+// query, or of the cursor if it is not empty
+func (s *server) listBuckets(query *bucketCursor, cursor string, limit uint32) (*api.BucketResponse, error) {
+	if cursor != "" {
+		c, err := parseBucketCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		if !query.sameListing(c) {
+			return nil, errors.New("cursor does not match the request")
+		}
+		query.offset = c.offset
+	}
+	sortKey, err := toVoteSortKey(query.sortBy)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.electionCommittee.ResultByHeight(query.height)
+	if err != nil {
+		return nil, err
+	}
+	var votes []*types.Vote
+	if query.name == "" {
+		votes = result.Votes()
+		if votes == nil {
+			return nil, errors.New("No buckets available")
+		}
+	} else {
+		name, err := hex.DecodeString(query.name)
+		if err != nil {
+			return nil, err
+		}
+		votes = result.VotesByDelegate(name)
+		if votes == nil {
+			return nil, errors.New("No buckets for the candidate")
+		}
+	}
+	offset := query.offset
+	if int(offset) >= len(votes) {
+		return nil, errors.New("offset is out of range")
+	}
+	// If limit is missing, return all buckets with indices starting from the offset
+	if limit == uint32(0) || uint64(offset)+uint64(limit) > uint64(len(votes)) {
 		limit = uint32(len(votes)) - offset
 	}
+	votes = types.SortVotes(votes, sortKey, query.descending, result.MintTime())
 	response := &api.BucketResponse{
+		Height:  strconv.FormatUint(query.height, 10),
 		Buckets: make([]*api.Bucket, limit),
 	}
 	for i := uint32(0); i < limit; i++ {
-		vote := votes[offset+i]
-		response.Buckets[i] = &api.Bucket{
-			Voter:             hex.EncodeToString(vote.Voter()),
-			Votes:             vote.Amount().Text(10),
-			WeightedVotes:     vote.WeightedAmount().Text(10),
-			RemainingDuration: vote.RemainingTime(mintTime).String(),
+		response.Buckets[i] = toBucket(votes[offset+i], result.MintTime())
+	}
+	if int(offset+limit) < len(votes) {
+		query.offset = offset + limit
+		response.NextCursor = query.String()
+	}
+
+	return response, nil
+}
+
+func toBucket(vote *types.Vote, mintTime time.Time) *api.Bucket {
+	bucket := &api.Bucket{
+		Voter:             hex.EncodeToString(vote.Voter()),
+		Votes:             vote.Amount().Text(10),
+		WeightedVotes:     vote.WeightedAmount().Text(10),
+		RemainingDuration: vote.RemainingTime(mintTime).String(),
+	}
+	if addr, err := types.IoAddress(vote.Voter()); err == nil {
+		bucket.VoterIoAddress = addr.String()
+	}
+	return bucket
+}
+
+// GetVoter returns the buckets, the totals and the backed delegates of a voter
+func (s *server) GetVoter(ctx context.Context, request *api.GetVoterRequest) (*api.VoterResponse, error) {
+	height, err := s.parseHeight(request.Height)
+	if err != nil {
+		return nil, err
+	}
+	voter, err := types.DecodeAddress(request.Voter)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.electionCommittee.ResultByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	summary := result.VoterSummary(voter)
+	if summary == nil {
+		return nil, errors.New("No buckets for the voter")
+	}
+	response := &api.VoterResponse{
+		Height:             strconv.FormatUint(height, 10),
+		Voter:              hex.EncodeToString(summary.Voter),
+		Buckets:            make([]*api.Bucket, len(summary.Votes)),
+		TotalVotes:         summary.TotalAmount.Text(10),
+		TotalWeightedVotes: summary.TotalWeightedAmount.Text(10),
+		Delegates:          make([]*api.VotedDelegate, len(summary.Delegates)),
+	}
+	if addr, err := types.IoAddress(summary.Voter); err == nil {
+		response.VoterIoAddress = addr.String()
+	}
+	for i, vote := range summary.Votes {
+		response.Buckets[i] = toBucket(vote, result.MintTime())
+	}
+	for i, name := range summary.Delegates {
+		votes := big.NewInt(0)
+		weightedVotes := big.NewInt(0)
+		for _, vote := range summary.Votes {
+			if bytes.Equal(vote.Candidate(), name) {
+				votes.Add(votes, vote.Amount())
+				weightedVotes.Add(weightedVotes, vote.WeightedAmount())
+			}
+		}
+		response.Delegates[i] = &api.VotedDelegate{
+			Name:          hex.EncodeToString(name),
+			Votes:         votes.Text(10),
+			WeightedVotes: weightedVotes.Text(10),
 		}
 	}
-	return response
+
+	return response, nil
 }
 
-// GetBuckets returns a list of buckets
-func (s *server) GetBuckets(ctx context.Context, request *api.GetBucketsRequest) (*api.BucketResponse, error) {
-	height, err := strconv.ParseUint(request.Height, 10, 64)
+// GetStatistics returns the decentralization and concentration statistics of the result
+func (s *server) GetStatistics(ctx context.Context, request *api.GetStatisticsRequest) (*api.Statistics, error) {
+	height, err := s.parseHeight(request.Height)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := s.electionCommittee.StatisticsByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	response := &api.Statistics{
+		Height:              strconv.FormatUint(height, 10),
+		NakamotoCoefficient: stats.NakamotoCoefficient,
+		VoterGini:           stats.VoterGini,
+		TopVoterShares:      make([]*api.TopVoterShare, len(stats.TopVoterShares)),
+		DelegateVoters:      make([]*api.DelegateVoters, len(stats.DelegateVoters)),
+		SelfStakingShare:    stats.SelfStakingShare,
+		TotalVoters:         stats.TotalVoters,
+	}
+	for i, share := range stats.TopVoterShares {
+		response.TopVoterShares[i] = &api.TopVoterShare{Top: share.Top, Share: share.Share}
+	}
+	for i, voters := range stats.DelegateVoters {
+		response.DelegateVoters[i] = &api.DelegateVoters{
+			Name:         hex.EncodeToString(voters.Name),
+			UniqueVoters: voters.UniqueVoters,
+		}
+	}
+
+	return response, nil
+}
+
+// GetBlockProducers returns the consensus delegates and the block producers of an epoch, selected from
+// the ranking adjusted by the probation list of the epoch. Delegates are qualified by their raw scores,
+// since the probation only lowers the ranks of the qualified ones
+func (s *server) GetBlockProducers(ctx context.Context, request *api.GetBlockProducersRequest) (*api.BlockProducersResponse, error) {
+	height, err := s.parseHeight(request.Height)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.electionCommittee.ProbationListByEpoch(request.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.electionCommittee.SummaryByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	adjusted, err := result.Adjust(list)
+	if err != nil {
+		return nil, err
+	}
+	unqualified := map[string]bool{}
+	for _, d := range result.Delegates() {
+		if s.unqualified(d) {
+			unqualified[hex.EncodeToString(d.Name())] = true
+		}
+	}
+	selector := s.selector.WithFilter(func(c *types.Candidate) bool {
+		return unqualified[hex.EncodeToString(c.Name())]
+	})
+	delegates := selector.ConsensusDelegates(adjusted)
+	producers := selector.BlockProducers(adjusted, request.Epoch)
+	response := &api.BlockProducersResponse{
+		Height:             strconv.FormatUint(height, 10),
+		Epoch:              request.Epoch,
+		ConsensusDelegates: make([]*api.Candidate, len(delegates)),
+		BlockProducers:     make([]*api.Candidate, len(producers)),
+	}
+	for i, d := range delegates {
+		response.ConsensusDelegates[i] = toCandidate(d)
+	}
+	for i, p := range producers {
+		response.BlockProducers[i] = toCandidate(p)
+	}
+
+	return response, nil
+}
+
+// GetRankings returns the raw ranking of the result along with the ranking adjusted by the probation
+// list of an epoch
+func (s *server) GetRankings(ctx context.Context, request *api.GetRankingsRequest) (*api.RankingsResponse, error) {
+	height, err := s.parseHeight(request.Height)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.electionCommittee.ProbationListByEpoch(request.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.electionCommittee.SummaryByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	adjusted, err := result.Adjust(list)
+	if err != nil {
+		return nil, err
+	}
+	adjustments := map[string]*types.Adjustment{}
+	for _, a := range adjusted.Adjustments() {
+		adjustments[hex.EncodeToString(a.Name)] = a
+	}
+	ranks := map[string]uint32{}
+	for i, d := range adjusted.Delegates() {
+		ranks[hex.EncodeToString(d.Name())] = uint32(i + 1)
+	}
+	delegates := result.Delegates()
+	response := &api.RankingsResponse{
+		Height:        strconv.FormatUint(height, 10),
+		ProbationList: toProbationList(list),
+		Rankings:      make([]*api.Ranking, len(delegates)),
+	}
+	for i, d := range delegates {
+		name := hex.EncodeToString(d.Name())
+		ranking := &api.Ranking{
+			Candidate:     toCandidate(d),
+			RawRank:       uint32(i + 1),
+			Rank:          ranks[name],
+			AdjustedScore: d.Score().Text(10),
+		}
+		if a, ok := adjustments[name]; ok {
+			ranking.AdjustedScore = a.Score.Text(10)
+			ranking.Probation = true
+			ranking.Excluded = a.Excluded
+		}
+		response.Rankings[i] = ranking
+	}
+
+	return response, nil
+}
+
+// PutProbationList stores the probation list of an epoch if enabled, which has no route on the gateway
+func (s *server) PutProbationList(ctx context.Context, request *api.ProbationList) (*empty.Empty, error) {
+	if !s.probationUpdate {
+		return nil, errors.New("probation update is disabled")
+	}
+	list := &types.ProbationList{
+		Epoch:         request.Epoch,
+		IntensityRate: request.IntensityRate,
+		Delegates:     make([][]byte, len(request.Delegates)),
+	}
+	for i, name := range request.Delegates {
+		var err error
+		if list.Delegates[i], err = hex.DecodeString(name); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.electionCommittee.PutProbationList(list); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func toProbationList(list *types.ProbationList) *api.ProbationList {
+	delegates := make([]string, len(list.Delegates))
+	for i, name := range list.Delegates {
+		delegates[i] = hex.EncodeToString(name)
+	}
+	return &api.ProbationList{
+		Epoch:         list.Epoch,
+		IntensityRate: list.IntensityRate,
+		Delegates:     delegates,
+	}
+}
+
+// GetExclusions returns the candidates and buckets excluded from the result with reasons
+func (s *server) GetExclusions(ctx context.Context, request *api.GetExclusionsRequest) (*api.ExclusionResponse, error) {
+	height, err := s.parseHeight(request.Height)
+	if err != nil {
+		return nil, err
+	}
+	name, err := hex.DecodeString(request.Name)
+	if err != nil {
+		return nil, err
+	}
+	audit, err := s.electionCommittee.AuditByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	candidates := audit.Candidates()
+	votes := audit.Votes()
+	malformed := audit.Malformed()
+	if len(name) != 0 {
+		candidates = []types.ExcludedCandidate{}
+		if c := audit.CandidateByName(name); c != nil {
+			candidates = append(candidates, *c)
+		}
+		votes = audit.VotesByCandidate(name)
+		malformed = []*types.Candidate{}
+		for _, c := range audit.Malformed() {
+			if bytes.Equal(c.Name(), name) {
+				malformed = append(malformed, c)
+			}
+		}
+	}
+	response := &api.ExclusionResponse{
+		Height:     strconv.FormatUint(height, 10),
+		Candidates: make([]*api.ExcludedCandidate, len(candidates)),
+		Buckets:    make([]*api.ExcludedBucket, len(votes)),
+		Pruned:     audit.Pruned(),
+		Malformed:  make([]*api.Candidate, len(malformed)),
+	}
+	for i, c := range candidates {
+		response.Candidates[i] = &api.ExcludedCandidate{
+			Candidate: toCandidate(c.Candidate),
+			Reason:    api.ExclusionReason(c.Reason),
+		}
+	}
+	for i, v := range votes {
+		response.Buckets[i] = &api.ExcludedBucket{
+			Voter:     hex.EncodeToString(v.Vote.Voter()),
+			Candidate: hex.EncodeToString(v.Vote.Candidate()),
+			Votes:     v.Vote.Amount().Text(10),
+			Reason:    api.ExclusionReason(v.Reason),
+		}
+	}
+	for i, c := range malformed {
+		response.Malformed[i] = toCandidate(c)
+	}
+
+	return response, nil
+}
+
+// Simulate returns the result with hypothetical changes and its difference from the real result
+func (s *server) Simulate(ctx context.Context, request *api.SimulateRequest) (*api.SimulateResponse, error) {
+	height, err := s.parseHeight(request.Height)
+	if err != nil {
+		return nil, err
+	}
+	scenario, err := toScenario(request)
+	if err != nil {
+		return nil, err
+	}
+	simulation, err := s.electionCommittee.Simulate(height, scenario)
+	if err != nil {
+		return nil, err
+	}
+	response := &api.SimulateResponse{
+		Height:     strconv.FormatUint(height, 10),
+		Candidates: make([]*api.Candidate, len(simulation.Result.Delegates())),
+		Changes:    make([]*api.DelegateChange, len(simulation.Changes)),
+	}
+	for i, d := range simulation.Result.Delegates() {
+		response.Candidates[i] = toCandidate(d)
+	}
+	for i, change := range simulation.Changes {
+		response.Changes[i] = &api.DelegateChange{
+			Name:     hex.EncodeToString(change.Name),
+			OldRank:  uint32(change.OldRank),
+			NewRank:  uint32(change.NewRank),
+			OldVotes: change.OldScore.Text(10),
+			NewVotes: change.NewScore.Text(10),
+		}
+	}
+
+	return response, nil
+}
+
+func toScenario(request *api.SimulateRequest) (*committee.Scenario, error) {
+	scenario := &committee.Scenario{}
+	var err error
+	for _, selector := range request.RemoveBuckets {
+		bs, err := toBucketSelector(selector)
+		if err != nil {
+			return nil, err
+		}
+		scenario.RemoveBuckets = append(scenario.RemoveBuckets, bs)
+	}
+	for _, move := range request.MoveVotes {
+		bs, err := toBucketSelector(move.Buckets)
+		if err != nil {
+			return nil, err
+		}
+		to, err := hex.DecodeString(move.To)
+		if err != nil {
+			return nil, err
+		}
+		scenario.MoveVotes = append(scenario.MoveVotes, committee.VoteMove{BucketSelector: bs, To: to})
+	}
+	for _, change := range request.ChangeDurations {
+		bs, err := toBucketSelector(change.Buckets)
+		if err != nil {
+			return nil, err
+		}
+		duration, err := ptypes.Duration(change.Duration)
+		if err != nil {
+			return nil, err
+		}
+		scenario.ChangeDurations = append(
+			scenario.ChangeDurations,
+			committee.DurationChange{BucketSelector: bs, Duration: duration},
+		)
+	}
+	for _, bucket := range request.AddBuckets {
+		vote, err := toSimulatedVote(bucket)
+		if err != nil {
+			return nil, err
+		}
+		scenario.AddBuckets = append(scenario.AddBuckets, vote)
+	}
+	if scenario.VoteThreshold, err = toThreshold(request.VoteThreshold); err != nil {
+		return nil, err
+	}
+	if scenario.ScoreThreshold, err = toThreshold(request.ScoreThreshold); err != nil {
+		return nil, err
+	}
+	if scenario.SelfStakingThreshold, err = toThreshold(request.SelfStakingThreshold); err != nil {
+		return nil, err
+	}
+
+	return scenario, nil
+}
+
+func toBucketSelector(selector *api.BucketSelector) (committee.BucketSelector, error) {
+	if selector == nil {
+		return committee.BucketSelector{}, errors.New("bucket selector is missing")
+	}
+	voter, err := types.DecodeAddress(selector.Voter)
+	if err != nil {
+		return committee.BucketSelector{}, err
+	}
+	candidate, err := hex.DecodeString(selector.Candidate)
+	if err != nil {
+		return committee.BucketSelector{}, err
+	}
+	return committee.BucketSelector{Voter: voter, Candidate: candidate}, nil
+}
+
+func toSimulatedVote(bucket *api.SimulatedBucket) (*types.Vote, error) {
+	voter, err := types.DecodeAddress(bucket.Voter)
+	if err != nil {
+		return nil, err
+	}
+	candidate, err := hex.DecodeString(bucket.Candidate)
+	if err != nil {
+		return nil, err
+	}
+	amount, ok := new(big.Int).SetString(bucket.Amount, 10)
+	if !ok {
+		return nil, errors.New("invalid bucket amount")
+	}
+	startTime, err := ptypes.Timestamp(bucket.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	duration, err := ptypes.Duration(bucket.Duration)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewVote(startTime, duration, amount, big.NewInt(0), voter, candidate, bucket.Decay)
+}
+
+func toThreshold(threshold string) (*big.Int, error) {
+	if threshold == "" {
+		return nil, nil
+	}
+	t, ok := new(big.Int).SetString(threshold, 10)
+	if !ok {
+		return nil, errors.New("invalid threshold")
+	}
+	return t, nil
+}
+
+// GetResultRoot returns the merkle roots of the result
+func (s *server) GetResultRoot(ctx context.Context, request *api.GetResultRootRequest) (*api.ResultRoot, error) {
+	height, err := s.parseHeight(request.Height)
+	if err != nil {
+		return nil, err
+	}
+	commitment, err := s.electionCommittee.CommitmentByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ResultRoot{
+		Height:        strconv.FormatUint(height, 10),
+		Root:          hex.EncodeToString(commitment.Root[:]),
+		DelegatesRoot: hex.EncodeToString(commitment.DelegatesRoot[:]),
+		VotesRoot:     hex.EncodeToString(commitment.VotesRoot[:]),
+	}, nil
+}
+
+// GetCandidateProof returns the inclusion proof of a candidate
+func (s *server) GetCandidateProof(ctx context.Context, request *api.GetCandidateProofRequest) (*api.MerkleProof, error) {
+	height, err := s.parseHeight(request.Height)
+	if err != nil {
+		return nil, err
+	}
+	name, err := hex.DecodeString(request.Name)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.electionCommittee.SummaryByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	commitment, err := s.electionCommittee.CommitmentByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := result.CandidateProof(name, commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	return toMerkleProof(height, commitment, proof), nil
+}
+
+// GetBucketProof returns the inclusion proof of a bucket
+func (s *server) GetBucketProof(ctx context.Context, request *api.GetBucketProofRequest) (*api.MerkleProof, error) {
+	height, err := s.parseHeight(request.Height)
+	if err != nil {
+		return nil, err
+	}
+	name, err := hex.DecodeString(request.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -329,14 +1067,68 @@ func (s *server) GetBuckets(ctx context.Context, request *api.GetBucketsRequest)
 	if err != nil {
 		return nil, err
 	}
-	votes := result.Votes()
-	if votes == nil {
-		return nil, errors.New("No buckets available")
+	commitment, err := s.electionCommittee.CommitmentByHeight(height)
+	if err != nil {
+		return nil, err
 	}
-	offset := request.Offset
-	if int(offset) >= len(votes) {
-		return nil, errors.New("offset is out of range")
+	proof, err := result.VoteProof(name, int(request.Index), commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	return toMerkleProof(height, commitment, proof), nil
+}
+
+func toMerkleProof(height uint64, commitment *types.ResultCommitment, proof *types.MerkleProof) *api.MerkleProof {
+	steps := make([]*api.ProofStep, len(proof.Steps))
+	for i, step := range proof.Steps {
+		steps[i] = &api.ProofStep{
+			Hash: hex.EncodeToString(step.Hash[:]),
+			Left: step.Left,
+		}
+	}
+	return &api.MerkleProof{
+		Height: strconv.FormatUint(height, 10),
+		Root:   hex.EncodeToString(commitment.Root[:]),
+		Leaf:   hex.EncodeToString(proof.Leaf),
+		Steps:  steps,
+	}
+}
+
+func toHealthStatus(status committee.STATUS) api.HealthCheckResponse_Status {
+	switch status {
+	case committee.ACTIVE:
+		return api.HealthCheckResponse_ACTIVE
+	case committee.INACTIVE:
+		return api.HealthCheckResponse_INACTIVE
+	default:
+		return api.HealthCheckResponse_STARTING
 	}
+}
 
-	return s.toBucketResponse(votes, offset, request.Limit, result.MintTime()), nil
+func toConsistencyFailures(failures []committee.ConsistencyFailure) ([]*api.ConsistencyFailure, error) {
+	retval := make([]*api.ConsistencyFailure, 0, len(failures))
+	for _, f := range failures {
+		firstFailure, err := ptypes.TimestampProto(f.FirstFailure)
+		if err != nil {
+			return nil, err
+		}
+		lastFailure, err := ptypes.TimestampProto(f.LastFailure)
+		if err != nil {
+			return nil, err
+		}
+		nextRetry, err := ptypes.TimestampProto(f.NextRetry)
+		if err != nil {
+			return nil, err
+		}
+		retval = append(retval, &api.ConsistencyFailure{
+			Height:       strconv.FormatUint(f.Height, 10),
+			Cause:        f.Cause,
+			Attempts:     f.Attempts,
+			FirstFailure: firstFailure,
+			LastFailure:  lastFailure,
+			NextRetry:    nextRetry,
+		})
+	}
+	return retval, nil
 }
@@ -0,0 +1,123 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package server
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/iotexproject/iotex-election/pb/api"
+)
+
+const (
+	defaultHeartbeatInterval = 10 * time.Second
+	defaultTopDelegates      = 36
+	// streamPageSize is the number of heights to load at a time while catching up
+	streamPageSize = 100
+)
+
+// StreamResults streams the summaries of the heights from the requested one, and then those of the
+// newly synced heights as they are stored, with heartbeats in between if idle
+func (s *server) StreamResults(request *api.StreamResultsRequest, stream api.APIService_StreamResultsServer) error {
+	// subscribe before catching up, such that no height is missed in between
+	updates, unsubscribe := s.electionCommittee.Subscribe()
+	defer unsubscribe()
+	next := s.electionCommittee.LatestHeight() + 1
+	switch {
+	case strings.EqualFold(request.FromHeight, latestHeight):
+		next = s.electionCommittee.LatestHeight()
+	case request.FromHeight != "":
+		height, err := strconv.ParseUint(request.FromHeight, 10, 64)
+		if err != nil {
+			return err
+		}
+		next = height
+	}
+	top := int(request.TopDelegates)
+	if top == 0 {
+		top = defaultTopDelegates
+	}
+	interval := s.heartbeatInterval
+	if interval == 0 {
+		interval = defaultHeartbeatInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for {
+			heights := s.electionCommittee.HeightsSince(next, streamPageSize)
+			if len(heights) == 0 {
+				break
+			}
+			for _, height := range heights {
+				summary, err := s.resultSummary(height, top)
+				if err != nil {
+					return err
+				}
+				if err := stream.Send(&api.ResultEvent{
+					Event: &api.ResultEvent_Result{Result: summary},
+				}); err != nil {
+					return err
+				}
+				next = height + 1
+			}
+		}
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-updates:
+		case <-ticker.C:
+			ts, err := ptypes.TimestampProto(time.Now())
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(&api.ResultEvent{
+				Event: &api.ResultEvent_Heartbeat{Heartbeat: &api.Heartbeat{
+					LatestHeight: strconv.FormatUint(s.electionCommittee.LatestHeight(), 10),
+					Time:         ts,
+				}},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *server) resultSummary(height uint64, top int) (*api.ResultSummary, error) {
+	result, err := s.electionCommittee.SummaryByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	mintTime, err := ptypes.TimestampProto(result.MintTime())
+	if err != nil {
+		return nil, err
+	}
+	delegates := result.Delegates()
+	summary := &api.ResultSummary{
+		Height:           strconv.FormatUint(height, 10),
+		MintTime:         mintTime,
+		TotalVotes:       result.TotalVotes().Text(10),
+		TotalVotedStakes: result.TotalVotedStakes().Text(10),
+	}
+	for i, d := range delegates {
+		if i < top {
+			summary.TopDelegates = append(summary.TopDelegates, toCandidate(d))
+		}
+		if !s.unqualified(d) {
+			summary.TotalCandidates++
+		}
+	}
+
+	return summary, nil
+}
@@ -10,9 +10,45 @@ import (
 	empty "github.com/golang/protobuf/ptypes/empty"
 	api "github.com/iotexproject/iotex-election/pb/api"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 	reflect "reflect"
 )
 
+// MockisResultEvent_Event is a mock of isResultEvent_Event interface
+type MockisResultEvent_Event struct {
+	ctrl     *gomock.Controller
+	recorder *MockisResultEvent_EventMockRecorder
+}
+
+// MockisResultEvent_EventMockRecorder is the mock recorder for MockisResultEvent_Event
+type MockisResultEvent_EventMockRecorder struct {
+	mock *MockisResultEvent_Event
+}
+
+// NewMockisResultEvent_Event creates a new mock instance
+func NewMockisResultEvent_Event(ctrl *gomock.Controller) *MockisResultEvent_Event {
+	mock := &MockisResultEvent_Event{ctrl: ctrl}
+	mock.recorder = &MockisResultEvent_EventMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockisResultEvent_Event) EXPECT() *MockisResultEvent_EventMockRecorder {
+	return m.recorder
+}
+
+// isResultEvent_Event mocks base method
+func (m *MockisResultEvent_Event) isResultEvent_Event() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "isResultEvent_Event")
+}
+
+// isResultEvent_Event indicates an expected call of isResultEvent_Event
+func (mr *MockisResultEvent_EventMockRecorder) isResultEvent_Event() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "isResultEvent_Event", reflect.TypeOf((*MockisResultEvent_Event)(nil).isResultEvent_Event))
+}
+
 // MockAPIServiceClient is a mock of APIServiceClient interface
 type MockAPIServiceClient struct {
 	ctrl     *gomock.Controller
@@ -38,6 +74,7 @@ func (m *MockAPIServiceClient) EXPECT() *MockAPIServiceClientMockRecorder {
 
 // GetMeta mocks base method
 func (m *MockAPIServiceClient) GetMeta(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*api.ChainMeta, error) {
+	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
@@ -50,12 +87,14 @@ func (m *MockAPIServiceClient) GetMeta(ctx context.Context, in *empty.Empty, opt
 
 // GetMeta indicates an expected call of GetMeta
 func (mr *MockAPIServiceClientMockRecorder) GetMeta(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMeta", reflect.TypeOf((*MockAPIServiceClient)(nil).GetMeta), varargs...)
 }
 
 // GetCandidates mocks base method
 func (m *MockAPIServiceClient) GetCandidates(ctx context.Context, in *api.GetCandidatesRequest, opts ...grpc.CallOption) (*api.CandidateResponse, error) {
+	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
@@ -68,12 +107,14 @@ func (m *MockAPIServiceClient) GetCandidates(ctx context.Context, in *api.GetCan
 
 // GetCandidates indicates an expected call of GetCandidates
 func (mr *MockAPIServiceClientMockRecorder) GetCandidates(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidates", reflect.TypeOf((*MockAPIServiceClient)(nil).GetCandidates), varargs...)
 }
 
 // GetCandidateByName mocks base method
 func (m *MockAPIServiceClient) GetCandidateByName(ctx context.Context, in *api.GetCandidateByNameRequest, opts ...grpc.CallOption) (*api.Candidate, error) {
+	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
@@ -86,12 +127,14 @@ func (m *MockAPIServiceClient) GetCandidateByName(ctx context.Context, in *api.G
 
 // GetCandidateByName indicates an expected call of GetCandidateByName
 func (mr *MockAPIServiceClientMockRecorder) GetCandidateByName(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidateByName", reflect.TypeOf((*MockAPIServiceClient)(nil).GetCandidateByName), varargs...)
 }
 
 // GetBucketsByCandidate mocks base method
 func (m *MockAPIServiceClient) GetBucketsByCandidate(ctx context.Context, in *api.GetBucketsByCandidateRequest, opts ...grpc.CallOption) (*api.BucketResponse, error) {
+	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
@@ -104,12 +147,74 @@ func (m *MockAPIServiceClient) GetBucketsByCandidate(ctx context.Context, in *ap
 
 // GetBucketsByCandidate indicates an expected call of GetBucketsByCandidate
 func (mr *MockAPIServiceClientMockRecorder) GetBucketsByCandidate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketsByCandidate", reflect.TypeOf((*MockAPIServiceClient)(nil).GetBucketsByCandidate), varargs...)
 }
 
+// GetBuckets mocks base method
+func (m *MockAPIServiceClient) GetBuckets(ctx context.Context, in *api.GetBucketsRequest, opts ...grpc.CallOption) (*api.BucketResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBuckets", varargs...)
+	ret0, _ := ret[0].(*api.BucketResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuckets indicates an expected call of GetBuckets
+func (mr *MockAPIServiceClientMockRecorder) GetBuckets(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuckets", reflect.TypeOf((*MockAPIServiceClient)(nil).GetBuckets), varargs...)
+}
+
+// GetVoter mocks base method
+func (m *MockAPIServiceClient) GetVoter(ctx context.Context, in *api.GetVoterRequest, opts ...grpc.CallOption) (*api.VoterResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVoter", varargs...)
+	ret0, _ := ret[0].(*api.VoterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVoter indicates an expected call of GetVoter
+func (mr *MockAPIServiceClientMockRecorder) GetVoter(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVoter", reflect.TypeOf((*MockAPIServiceClient)(nil).GetVoter), varargs...)
+}
+
+// GetStatistics mocks base method
+func (m *MockAPIServiceClient) GetStatistics(ctx context.Context, in *api.GetStatisticsRequest, opts ...grpc.CallOption) (*api.Statistics, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStatistics", varargs...)
+	ret0, _ := ret[0].(*api.Statistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatistics indicates an expected call of GetStatistics
+func (mr *MockAPIServiceClientMockRecorder) GetStatistics(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatistics", reflect.TypeOf((*MockAPIServiceClient)(nil).GetStatistics), varargs...)
+}
+
 // IsHealth mocks base method
 func (m *MockAPIServiceClient) IsHealth(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*api.HealthCheckResponse, error) {
+	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
@@ -122,10 +227,394 @@ func (m *MockAPIServiceClient) IsHealth(ctx context.Context, in *empty.Empty, op
 
 // IsHealth indicates an expected call of IsHealth
 func (mr *MockAPIServiceClientMockRecorder) IsHealth(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsHealth", reflect.TypeOf((*MockAPIServiceClient)(nil).IsHealth), varargs...)
 }
 
+// GetStatus mocks base method
+func (m *MockAPIServiceClient) GetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*api.SyncStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStatus", varargs...)
+	ret0, _ := ret[0].(*api.SyncStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatus indicates an expected call of GetStatus
+func (mr *MockAPIServiceClientMockRecorder) GetStatus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockAPIServiceClient)(nil).GetStatus), varargs...)
+}
+
+// GetExclusions mocks base method
+func (m *MockAPIServiceClient) GetExclusions(ctx context.Context, in *api.GetExclusionsRequest, opts ...grpc.CallOption) (*api.ExclusionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetExclusions", varargs...)
+	ret0, _ := ret[0].(*api.ExclusionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExclusions indicates an expected call of GetExclusions
+func (mr *MockAPIServiceClientMockRecorder) GetExclusions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExclusions", reflect.TypeOf((*MockAPIServiceClient)(nil).GetExclusions), varargs...)
+}
+
+// Simulate mocks base method
+func (m *MockAPIServiceClient) Simulate(ctx context.Context, in *api.SimulateRequest, opts ...grpc.CallOption) (*api.SimulateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Simulate", varargs...)
+	ret0, _ := ret[0].(*api.SimulateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Simulate indicates an expected call of Simulate
+func (mr *MockAPIServiceClientMockRecorder) Simulate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Simulate", reflect.TypeOf((*MockAPIServiceClient)(nil).Simulate), varargs...)
+}
+
+// GetResultRoot mocks base method
+func (m *MockAPIServiceClient) GetResultRoot(ctx context.Context, in *api.GetResultRootRequest, opts ...grpc.CallOption) (*api.ResultRoot, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetResultRoot", varargs...)
+	ret0, _ := ret[0].(*api.ResultRoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResultRoot indicates an expected call of GetResultRoot
+func (mr *MockAPIServiceClientMockRecorder) GetResultRoot(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResultRoot", reflect.TypeOf((*MockAPIServiceClient)(nil).GetResultRoot), varargs...)
+}
+
+// GetCandidateProof mocks base method
+func (m *MockAPIServiceClient) GetCandidateProof(ctx context.Context, in *api.GetCandidateProofRequest, opts ...grpc.CallOption) (*api.MerkleProof, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCandidateProof", varargs...)
+	ret0, _ := ret[0].(*api.MerkleProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCandidateProof indicates an expected call of GetCandidateProof
+func (mr *MockAPIServiceClientMockRecorder) GetCandidateProof(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidateProof", reflect.TypeOf((*MockAPIServiceClient)(nil).GetCandidateProof), varargs...)
+}
+
+// GetBucketProof mocks base method
+func (m *MockAPIServiceClient) GetBucketProof(ctx context.Context, in *api.GetBucketProofRequest, opts ...grpc.CallOption) (*api.MerkleProof, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBucketProof", varargs...)
+	ret0, _ := ret[0].(*api.MerkleProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketProof indicates an expected call of GetBucketProof
+func (mr *MockAPIServiceClientMockRecorder) GetBucketProof(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketProof", reflect.TypeOf((*MockAPIServiceClient)(nil).GetBucketProof), varargs...)
+}
+
+// GetBlockProducers mocks base method
+func (m *MockAPIServiceClient) GetBlockProducers(ctx context.Context, in *api.GetBlockProducersRequest, opts ...grpc.CallOption) (*api.BlockProducersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBlockProducers", varargs...)
+	ret0, _ := ret[0].(*api.BlockProducersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockProducers indicates an expected call of GetBlockProducers
+func (mr *MockAPIServiceClientMockRecorder) GetBlockProducers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockProducers", reflect.TypeOf((*MockAPIServiceClient)(nil).GetBlockProducers), varargs...)
+}
+
+// GetRankings mocks base method
+func (m *MockAPIServiceClient) GetRankings(ctx context.Context, in *api.GetRankingsRequest, opts ...grpc.CallOption) (*api.RankingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRankings", varargs...)
+	ret0, _ := ret[0].(*api.RankingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRankings indicates an expected call of GetRankings
+func (mr *MockAPIServiceClientMockRecorder) GetRankings(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRankings", reflect.TypeOf((*MockAPIServiceClient)(nil).GetRankings), varargs...)
+}
+
+// PutProbationList mocks base method
+func (m *MockAPIServiceClient) PutProbationList(ctx context.Context, in *api.ProbationList, opts ...grpc.CallOption) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutProbationList", varargs...)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutProbationList indicates an expected call of PutProbationList
+func (mr *MockAPIServiceClientMockRecorder) PutProbationList(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutProbationList", reflect.TypeOf((*MockAPIServiceClient)(nil).PutProbationList), varargs...)
+}
+
+// StreamResults mocks base method
+func (m *MockAPIServiceClient) StreamResults(ctx context.Context, in *api.StreamResultsRequest, opts ...grpc.CallOption) (api.APIService_StreamResultsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamResults", varargs...)
+	ret0, _ := ret[0].(api.APIService_StreamResultsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamResults indicates an expected call of StreamResults
+func (mr *MockAPIServiceClientMockRecorder) StreamResults(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamResults", reflect.TypeOf((*MockAPIServiceClient)(nil).StreamResults), varargs...)
+}
+
+// GetHeightByTime mocks base method
+func (m *MockAPIServiceClient) GetHeightByTime(ctx context.Context, in *api.GetHeightByTimeRequest, opts ...grpc.CallOption) (*api.HeightResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHeightByTime", varargs...)
+	ret0, _ := ret[0].(*api.HeightResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeightByTime indicates an expected call of GetHeightByTime
+func (mr *MockAPIServiceClientMockRecorder) GetHeightByTime(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeightByTime", reflect.TypeOf((*MockAPIServiceClient)(nil).GetHeightByTime), varargs...)
+}
+
+// GetResultByTime mocks base method
+func (m *MockAPIServiceClient) GetResultByTime(ctx context.Context, in *api.GetResultByTimeRequest, opts ...grpc.CallOption) (*api.ResultSummary, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetResultByTime", varargs...)
+	ret0, _ := ret[0].(*api.ResultSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResultByTime indicates an expected call of GetResultByTime
+func (mr *MockAPIServiceClientMockRecorder) GetResultByTime(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResultByTime", reflect.TypeOf((*MockAPIServiceClient)(nil).GetResultByTime), varargs...)
+}
+
+// GetCandidateHistory mocks base method
+func (m *MockAPIServiceClient) GetCandidateHistory(ctx context.Context, in *api.GetCandidateHistoryRequest, opts ...grpc.CallOption) (*api.CandidateHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCandidateHistory", varargs...)
+	ret0, _ := ret[0].(*api.CandidateHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCandidateHistory indicates an expected call of GetCandidateHistory
+func (mr *MockAPIServiceClientMockRecorder) GetCandidateHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidateHistory", reflect.TypeOf((*MockAPIServiceClient)(nil).GetCandidateHistory), varargs...)
+}
+
+// MockAPIService_StreamResultsClient is a mock of APIService_StreamResultsClient interface
+type MockAPIService_StreamResultsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIService_StreamResultsClientMockRecorder
+}
+
+// MockAPIService_StreamResultsClientMockRecorder is the mock recorder for MockAPIService_StreamResultsClient
+type MockAPIService_StreamResultsClientMockRecorder struct {
+	mock *MockAPIService_StreamResultsClient
+}
+
+// NewMockAPIService_StreamResultsClient creates a new mock instance
+func NewMockAPIService_StreamResultsClient(ctrl *gomock.Controller) *MockAPIService_StreamResultsClient {
+	mock := &MockAPIService_StreamResultsClient{ctrl: ctrl}
+	mock.recorder = &MockAPIService_StreamResultsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAPIService_StreamResultsClient) EXPECT() *MockAPIService_StreamResultsClientMockRecorder {
+	return m.recorder
+}
+
+// Recv mocks base method
+func (m *MockAPIService_StreamResultsClient) Recv() (*api.ResultEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*api.ResultEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv
+func (mr *MockAPIService_StreamResultsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAPIService_StreamResultsClient)(nil).Recv))
+}
+
+// Header mocks base method
+func (m *MockAPIService_StreamResultsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header
+func (mr *MockAPIService_StreamResultsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAPIService_StreamResultsClient)(nil).Header))
+}
+
+// Trailer mocks base method
+func (m *MockAPIService_StreamResultsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer
+func (mr *MockAPIService_StreamResultsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAPIService_StreamResultsClient)(nil).Trailer))
+}
+
+// CloseSend mocks base method
+func (m *MockAPIService_StreamResultsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockAPIService_StreamResultsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAPIService_StreamResultsClient)(nil).CloseSend))
+}
+
+// Context mocks base method
+func (m *MockAPIService_StreamResultsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockAPIService_StreamResultsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAPIService_StreamResultsClient)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockAPIService_StreamResultsClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockAPIService_StreamResultsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAPIService_StreamResultsClient)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockAPIService_StreamResultsClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockAPIService_StreamResultsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAPIService_StreamResultsClient)(nil).RecvMsg), m)
+}
+
 // MockAPIServiceServer is a mock of APIServiceServer interface
 type MockAPIServiceServer struct {
 	ctrl     *gomock.Controller
@@ -151,6 +640,7 @@ func (m *MockAPIServiceServer) EXPECT() *MockAPIServiceServerMockRecorder {
 
 // GetMeta mocks base method
 func (m *MockAPIServiceServer) GetMeta(arg0 context.Context, arg1 *empty.Empty) (*api.ChainMeta, error) {
+	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetMeta", arg0, arg1)
 	ret0, _ := ret[0].(*api.ChainMeta)
 	ret1, _ := ret[1].(error)
@@ -159,11 +649,13 @@ func (m *MockAPIServiceServer) GetMeta(arg0 context.Context, arg1 *empty.Empty)
 
 // GetMeta indicates an expected call of GetMeta
 func (mr *MockAPIServiceServerMockRecorder) GetMeta(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMeta", reflect.TypeOf((*MockAPIServiceServer)(nil).GetMeta), arg0, arg1)
 }
 
 // GetCandidates mocks base method
 func (m *MockAPIServiceServer) GetCandidates(arg0 context.Context, arg1 *api.GetCandidatesRequest) (*api.CandidateResponse, error) {
+	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetCandidates", arg0, arg1)
 	ret0, _ := ret[0].(*api.CandidateResponse)
 	ret1, _ := ret[1].(error)
@@ -172,11 +664,13 @@ func (m *MockAPIServiceServer) GetCandidates(arg0 context.Context, arg1 *api.Get
 
 // GetCandidates indicates an expected call of GetCandidates
 func (mr *MockAPIServiceServerMockRecorder) GetCandidates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidates", reflect.TypeOf((*MockAPIServiceServer)(nil).GetCandidates), arg0, arg1)
 }
 
 // GetCandidateByName mocks base method
 func (m *MockAPIServiceServer) GetCandidateByName(arg0 context.Context, arg1 *api.GetCandidateByNameRequest) (*api.Candidate, error) {
+	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetCandidateByName", arg0, arg1)
 	ret0, _ := ret[0].(*api.Candidate)
 	ret1, _ := ret[1].(error)
@@ -185,11 +679,13 @@ func (m *MockAPIServiceServer) GetCandidateByName(arg0 context.Context, arg1 *ap
 
 // GetCandidateByName indicates an expected call of GetCandidateByName
 func (mr *MockAPIServiceServerMockRecorder) GetCandidateByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidateByName", reflect.TypeOf((*MockAPIServiceServer)(nil).GetCandidateByName), arg0, arg1)
 }
 
 // GetBucketsByCandidate mocks base method
 func (m *MockAPIServiceServer) GetBucketsByCandidate(arg0 context.Context, arg1 *api.GetBucketsByCandidateRequest) (*api.BucketResponse, error) {
+	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBucketsByCandidate", arg0, arg1)
 	ret0, _ := ret[0].(*api.BucketResponse)
 	ret1, _ := ret[1].(error)
@@ -198,11 +694,58 @@ func (m *MockAPIServiceServer) GetBucketsByCandidate(arg0 context.Context, arg1
 
 // GetBucketsByCandidate indicates an expected call of GetBucketsByCandidate
 func (mr *MockAPIServiceServerMockRecorder) GetBucketsByCandidate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketsByCandidate", reflect.TypeOf((*MockAPIServiceServer)(nil).GetBucketsByCandidate), arg0, arg1)
 }
 
+// GetBuckets mocks base method
+func (m *MockAPIServiceServer) GetBuckets(arg0 context.Context, arg1 *api.GetBucketsRequest) (*api.BucketResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBuckets", arg0, arg1)
+	ret0, _ := ret[0].(*api.BucketResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuckets indicates an expected call of GetBuckets
+func (mr *MockAPIServiceServerMockRecorder) GetBuckets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuckets", reflect.TypeOf((*MockAPIServiceServer)(nil).GetBuckets), arg0, arg1)
+}
+
+// GetVoter mocks base method
+func (m *MockAPIServiceServer) GetVoter(arg0 context.Context, arg1 *api.GetVoterRequest) (*api.VoterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVoter", arg0, arg1)
+	ret0, _ := ret[0].(*api.VoterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVoter indicates an expected call of GetVoter
+func (mr *MockAPIServiceServerMockRecorder) GetVoter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVoter", reflect.TypeOf((*MockAPIServiceServer)(nil).GetVoter), arg0, arg1)
+}
+
+// GetStatistics mocks base method
+func (m *MockAPIServiceServer) GetStatistics(arg0 context.Context, arg1 *api.GetStatisticsRequest) (*api.Statistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatistics", arg0, arg1)
+	ret0, _ := ret[0].(*api.Statistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatistics indicates an expected call of GetStatistics
+func (mr *MockAPIServiceServerMockRecorder) GetStatistics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatistics", reflect.TypeOf((*MockAPIServiceServer)(nil).GetStatistics), arg0, arg1)
+}
+
 // IsHealth mocks base method
 func (m *MockAPIServiceServer) IsHealth(arg0 context.Context, arg1 *empty.Empty) (*api.HealthCheckResponse, error) {
+	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "IsHealth", arg0, arg1)
 	ret0, _ := ret[0].(*api.HealthCheckResponse)
 	ret1, _ := ret[1].(error)
@@ -211,5 +754,319 @@ func (m *MockAPIServiceServer) IsHealth(arg0 context.Context, arg1 *empty.Empty)
 
 // IsHealth indicates an expected call of IsHealth
 func (mr *MockAPIServiceServerMockRecorder) IsHealth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsHealth", reflect.TypeOf((*MockAPIServiceServer)(nil).IsHealth), arg0, arg1)
 }
+
+// GetStatus mocks base method
+func (m *MockAPIServiceServer) GetStatus(arg0 context.Context, arg1 *empty.Empty) (*api.SyncStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus", arg0, arg1)
+	ret0, _ := ret[0].(*api.SyncStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatus indicates an expected call of GetStatus
+func (mr *MockAPIServiceServerMockRecorder) GetStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockAPIServiceServer)(nil).GetStatus), arg0, arg1)
+}
+
+// GetExclusions mocks base method
+func (m *MockAPIServiceServer) GetExclusions(arg0 context.Context, arg1 *api.GetExclusionsRequest) (*api.ExclusionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExclusions", arg0, arg1)
+	ret0, _ := ret[0].(*api.ExclusionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExclusions indicates an expected call of GetExclusions
+func (mr *MockAPIServiceServerMockRecorder) GetExclusions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExclusions", reflect.TypeOf((*MockAPIServiceServer)(nil).GetExclusions), arg0, arg1)
+}
+
+// Simulate mocks base method
+func (m *MockAPIServiceServer) Simulate(arg0 context.Context, arg1 *api.SimulateRequest) (*api.SimulateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Simulate", arg0, arg1)
+	ret0, _ := ret[0].(*api.SimulateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Simulate indicates an expected call of Simulate
+func (mr *MockAPIServiceServerMockRecorder) Simulate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Simulate", reflect.TypeOf((*MockAPIServiceServer)(nil).Simulate), arg0, arg1)
+}
+
+// GetResultRoot mocks base method
+func (m *MockAPIServiceServer) GetResultRoot(arg0 context.Context, arg1 *api.GetResultRootRequest) (*api.ResultRoot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResultRoot", arg0, arg1)
+	ret0, _ := ret[0].(*api.ResultRoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResultRoot indicates an expected call of GetResultRoot
+func (mr *MockAPIServiceServerMockRecorder) GetResultRoot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResultRoot", reflect.TypeOf((*MockAPIServiceServer)(nil).GetResultRoot), arg0, arg1)
+}
+
+// GetCandidateProof mocks base method
+func (m *MockAPIServiceServer) GetCandidateProof(arg0 context.Context, arg1 *api.GetCandidateProofRequest) (*api.MerkleProof, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCandidateProof", arg0, arg1)
+	ret0, _ := ret[0].(*api.MerkleProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCandidateProof indicates an expected call of GetCandidateProof
+func (mr *MockAPIServiceServerMockRecorder) GetCandidateProof(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidateProof", reflect.TypeOf((*MockAPIServiceServer)(nil).GetCandidateProof), arg0, arg1)
+}
+
+// GetBucketProof mocks base method
+func (m *MockAPIServiceServer) GetBucketProof(arg0 context.Context, arg1 *api.GetBucketProofRequest) (*api.MerkleProof, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBucketProof", arg0, arg1)
+	ret0, _ := ret[0].(*api.MerkleProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketProof indicates an expected call of GetBucketProof
+func (mr *MockAPIServiceServerMockRecorder) GetBucketProof(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketProof", reflect.TypeOf((*MockAPIServiceServer)(nil).GetBucketProof), arg0, arg1)
+}
+
+// GetBlockProducers mocks base method
+func (m *MockAPIServiceServer) GetBlockProducers(arg0 context.Context, arg1 *api.GetBlockProducersRequest) (*api.BlockProducersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockProducers", arg0, arg1)
+	ret0, _ := ret[0].(*api.BlockProducersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockProducers indicates an expected call of GetBlockProducers
+func (mr *MockAPIServiceServerMockRecorder) GetBlockProducers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockProducers", reflect.TypeOf((*MockAPIServiceServer)(nil).GetBlockProducers), arg0, arg1)
+}
+
+// GetRankings mocks base method
+func (m *MockAPIServiceServer) GetRankings(arg0 context.Context, arg1 *api.GetRankingsRequest) (*api.RankingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRankings", arg0, arg1)
+	ret0, _ := ret[0].(*api.RankingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRankings indicates an expected call of GetRankings
+func (mr *MockAPIServiceServerMockRecorder) GetRankings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRankings", reflect.TypeOf((*MockAPIServiceServer)(nil).GetRankings), arg0, arg1)
+}
+
+// PutProbationList mocks base method
+func (m *MockAPIServiceServer) PutProbationList(arg0 context.Context, arg1 *api.ProbationList) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutProbationList", arg0, arg1)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutProbationList indicates an expected call of PutProbationList
+func (mr *MockAPIServiceServerMockRecorder) PutProbationList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutProbationList", reflect.TypeOf((*MockAPIServiceServer)(nil).PutProbationList), arg0, arg1)
+}
+
+// StreamResults mocks base method
+func (m *MockAPIServiceServer) StreamResults(arg0 *api.StreamResultsRequest, arg1 api.APIService_StreamResultsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamResults", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamResults indicates an expected call of StreamResults
+func (mr *MockAPIServiceServerMockRecorder) StreamResults(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamResults", reflect.TypeOf((*MockAPIServiceServer)(nil).StreamResults), arg0, arg1)
+}
+
+// GetHeightByTime mocks base method
+func (m *MockAPIServiceServer) GetHeightByTime(arg0 context.Context, arg1 *api.GetHeightByTimeRequest) (*api.HeightResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeightByTime", arg0, arg1)
+	ret0, _ := ret[0].(*api.HeightResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeightByTime indicates an expected call of GetHeightByTime
+func (mr *MockAPIServiceServerMockRecorder) GetHeightByTime(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeightByTime", reflect.TypeOf((*MockAPIServiceServer)(nil).GetHeightByTime), arg0, arg1)
+}
+
+// GetResultByTime mocks base method
+func (m *MockAPIServiceServer) GetResultByTime(arg0 context.Context, arg1 *api.GetResultByTimeRequest) (*api.ResultSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResultByTime", arg0, arg1)
+	ret0, _ := ret[0].(*api.ResultSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResultByTime indicates an expected call of GetResultByTime
+func (mr *MockAPIServiceServerMockRecorder) GetResultByTime(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResultByTime", reflect.TypeOf((*MockAPIServiceServer)(nil).GetResultByTime), arg0, arg1)
+}
+
+// GetCandidateHistory mocks base method
+func (m *MockAPIServiceServer) GetCandidateHistory(arg0 context.Context, arg1 *api.GetCandidateHistoryRequest) (*api.CandidateHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCandidateHistory", arg0, arg1)
+	ret0, _ := ret[0].(*api.CandidateHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCandidateHistory indicates an expected call of GetCandidateHistory
+func (mr *MockAPIServiceServerMockRecorder) GetCandidateHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidateHistory", reflect.TypeOf((*MockAPIServiceServer)(nil).GetCandidateHistory), arg0, arg1)
+}
+
+// MockAPIService_StreamResultsServer is a mock of APIService_StreamResultsServer interface
+type MockAPIService_StreamResultsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIService_StreamResultsServerMockRecorder
+}
+
+// MockAPIService_StreamResultsServerMockRecorder is the mock recorder for MockAPIService_StreamResultsServer
+type MockAPIService_StreamResultsServerMockRecorder struct {
+	mock *MockAPIService_StreamResultsServer
+}
+
+// NewMockAPIService_StreamResultsServer creates a new mock instance
+func NewMockAPIService_StreamResultsServer(ctrl *gomock.Controller) *MockAPIService_StreamResultsServer {
+	mock := &MockAPIService_StreamResultsServer{ctrl: ctrl}
+	mock.recorder = &MockAPIService_StreamResultsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAPIService_StreamResultsServer) EXPECT() *MockAPIService_StreamResultsServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockAPIService_StreamResultsServer) Send(arg0 *api.ResultEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockAPIService_StreamResultsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAPIService_StreamResultsServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockAPIService_StreamResultsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockAPIService_StreamResultsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAPIService_StreamResultsServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockAPIService_StreamResultsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockAPIService_StreamResultsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAPIService_StreamResultsServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockAPIService_StreamResultsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockAPIService_StreamResultsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAPIService_StreamResultsServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method
+func (m *MockAPIService_StreamResultsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockAPIService_StreamResultsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAPIService_StreamResultsServer)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockAPIService_StreamResultsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockAPIService_StreamResultsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAPIService_StreamResultsServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockAPIService_StreamResultsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockAPIService_StreamResultsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAPIService_StreamResultsServer)(nil).RecvMsg), m)
+}
@@ -38,6 +38,7 @@ func (m *MockCommittee) EXPECT() *MockCommitteeMockRecorder {
 
 // Start mocks base method
 func (m *MockCommittee) Start(arg0 context.Context) error {
+	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Start", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
@@ -45,11 +46,13 @@ func (m *MockCommittee) Start(arg0 context.Context) error {
 
 // Start indicates an expected call of Start
 func (mr *MockCommitteeMockRecorder) Start(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockCommittee)(nil).Start), arg0)
 }
 
 // Stop mocks base method
 func (m *MockCommittee) Stop(arg0 context.Context) error {
+	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Stop", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
@@ -57,11 +60,13 @@ func (m *MockCommittee) Stop(arg0 context.Context) error {
 
 // Stop indicates an expected call of Stop
 func (mr *MockCommitteeMockRecorder) Stop(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockCommittee)(nil).Stop), arg0)
 }
 
 // ResultByHeight mocks base method
 func (m *MockCommittee) ResultByHeight(height uint64) (*types.ElectionResult, error) {
+	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ResultByHeight", height)
 	ret0, _ := ret[0].(*types.ElectionResult)
 	ret1, _ := ret[1].(error)
@@ -70,11 +75,28 @@ func (m *MockCommittee) ResultByHeight(height uint64) (*types.ElectionResult, er
 
 // ResultByHeight indicates an expected call of ResultByHeight
 func (mr *MockCommitteeMockRecorder) ResultByHeight(height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResultByHeight", reflect.TypeOf((*MockCommittee)(nil).ResultByHeight), height)
 }
 
+// SummaryByHeight mocks base method
+func (m *MockCommittee) SummaryByHeight(height uint64) (*types.ElectionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummaryByHeight", height)
+	ret0, _ := ret[0].(*types.ElectionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummaryByHeight indicates an expected call of SummaryByHeight
+func (mr *MockCommitteeMockRecorder) SummaryByHeight(height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummaryByHeight", reflect.TypeOf((*MockCommittee)(nil).SummaryByHeight), height)
+}
+
 // FetchResultByHeight mocks base method
 func (m *MockCommittee) FetchResultByHeight(height uint64) (*types.ElectionResult, error) {
+	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FetchResultByHeight", height)
 	ret0, _ := ret[0].(*types.ElectionResult)
 	ret1, _ := ret[1].(error)
@@ -83,11 +105,13 @@ func (m *MockCommittee) FetchResultByHeight(height uint64) (*types.ElectionResul
 
 // FetchResultByHeight indicates an expected call of FetchResultByHeight
 func (mr *MockCommitteeMockRecorder) FetchResultByHeight(height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchResultByHeight", reflect.TypeOf((*MockCommittee)(nil).FetchResultByHeight), height)
 }
 
 // HeightByTime mocks base method
 func (m *MockCommittee) HeightByTime(timestamp time.Time) (uint64, error) {
+	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "HeightByTime", timestamp)
 	ret0, _ := ret[0].(uint64)
 	ret1, _ := ret[1].(error)
@@ -96,11 +120,13 @@ func (m *MockCommittee) HeightByTime(timestamp time.Time) (uint64, error) {
 
 // HeightByTime indicates an expected call of HeightByTime
 func (mr *MockCommitteeMockRecorder) HeightByTime(timestamp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeightByTime", reflect.TypeOf((*MockCommittee)(nil).HeightByTime), timestamp)
 }
 
 // LatestHeight mocks base method
 func (m *MockCommittee) LatestHeight() uint64 {
+	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "LatestHeight")
 	ret0, _ := ret[0].(uint64)
 	return ret0
@@ -108,11 +134,57 @@ func (m *MockCommittee) LatestHeight() uint64 {
 
 // LatestHeight indicates an expected call of LatestHeight
 func (mr *MockCommitteeMockRecorder) LatestHeight() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestHeight", reflect.TypeOf((*MockCommittee)(nil).LatestHeight))
 }
 
+// HeightAtOrBefore mocks base method
+func (m *MockCommittee) HeightAtOrBefore(height uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeightAtOrBefore", height)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeightAtOrBefore indicates an expected call of HeightAtOrBefore
+func (mr *MockCommitteeMockRecorder) HeightAtOrBefore(height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeightAtOrBefore", reflect.TypeOf((*MockCommittee)(nil).HeightAtOrBefore), height)
+}
+
+// HeightsSince mocks base method
+func (m *MockCommittee) HeightsSince(height uint64, limit int) []uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeightsSince", height, limit)
+	ret0, _ := ret[0].([]uint64)
+	return ret0
+}
+
+// HeightsSince indicates an expected call of HeightsSince
+func (mr *MockCommitteeMockRecorder) HeightsSince(height, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeightsSince", reflect.TypeOf((*MockCommittee)(nil).HeightsSince), height, limit)
+}
+
+// Subscribe mocks base method
+func (m *MockCommittee) Subscribe() (<-chan struct{}, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe")
+	ret0, _ := ret[0].(<-chan struct{})
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe
+func (mr *MockCommitteeMockRecorder) Subscribe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockCommittee)(nil).Subscribe))
+}
+
 // Status mocks base method
 func (m *MockCommittee) Status() committee.STATUS {
+	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Status")
 	ret0, _ := ret[0].(committee.STATUS)
 	return ret0
@@ -120,5 +192,137 @@ func (m *MockCommittee) Status() committee.STATUS {
 
 // Status indicates an expected call of Status
 func (mr *MockCommitteeMockRecorder) Status() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockCommittee)(nil).Status))
 }
+
+// CacheStats mocks base method
+func (m *MockCommittee) CacheStats() committee.CacheStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CacheStats")
+	ret0, _ := ret[0].(committee.CacheStats)
+	return ret0
+}
+
+// CacheStats indicates an expected call of CacheStats
+func (mr *MockCommitteeMockRecorder) CacheStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheStats", reflect.TypeOf((*MockCommittee)(nil).CacheStats))
+}
+
+// CommitmentByHeight mocks base method
+func (m *MockCommittee) CommitmentByHeight(height uint64) (*types.ResultCommitment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitmentByHeight", height)
+	ret0, _ := ret[0].(*types.ResultCommitment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitmentByHeight indicates an expected call of CommitmentByHeight
+func (mr *MockCommitteeMockRecorder) CommitmentByHeight(height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitmentByHeight", reflect.TypeOf((*MockCommittee)(nil).CommitmentByHeight), height)
+}
+
+// Failures mocks base method
+func (m *MockCommittee) Failures() []committee.ConsistencyFailure {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Failures")
+	ret0, _ := ret[0].([]committee.ConsistencyFailure)
+	return ret0
+}
+
+// Failures indicates an expected call of Failures
+func (mr *MockCommitteeMockRecorder) Failures() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Failures", reflect.TypeOf((*MockCommittee)(nil).Failures))
+}
+
+// SyncStatus mocks base method
+func (m *MockCommittee) SyncStatus() committee.SyncStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncStatus")
+	ret0, _ := ret[0].(committee.SyncStatus)
+	return ret0
+}
+
+// SyncStatus indicates an expected call of SyncStatus
+func (mr *MockCommitteeMockRecorder) SyncStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStatus", reflect.TypeOf((*MockCommittee)(nil).SyncStatus))
+}
+
+// AuditByHeight mocks base method
+func (m *MockCommittee) AuditByHeight(height uint64) (*types.ExclusionAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditByHeight", height)
+	ret0, _ := ret[0].(*types.ExclusionAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditByHeight indicates an expected call of AuditByHeight
+func (mr *MockCommitteeMockRecorder) AuditByHeight(height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditByHeight", reflect.TypeOf((*MockCommittee)(nil).AuditByHeight), height)
+}
+
+// StatisticsByHeight mocks base method
+func (m *MockCommittee) StatisticsByHeight(height uint64) (*types.Statistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatisticsByHeight", height)
+	ret0, _ := ret[0].(*types.Statistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StatisticsByHeight indicates an expected call of StatisticsByHeight
+func (mr *MockCommitteeMockRecorder) StatisticsByHeight(height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatisticsByHeight", reflect.TypeOf((*MockCommittee)(nil).StatisticsByHeight), height)
+}
+
+// Simulate mocks base method
+func (m *MockCommittee) Simulate(height uint64, scenario *committee.Scenario) (*committee.Simulation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Simulate", height, scenario)
+	ret0, _ := ret[0].(*committee.Simulation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Simulate indicates an expected call of Simulate
+func (mr *MockCommitteeMockRecorder) Simulate(height, scenario interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Simulate", reflect.TypeOf((*MockCommittee)(nil).Simulate), height, scenario)
+}
+
+// PutProbationList mocks base method
+func (m *MockCommittee) PutProbationList(list *types.ProbationList) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutProbationList", list)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutProbationList indicates an expected call of PutProbationList
+func (mr *MockCommitteeMockRecorder) PutProbationList(list interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutProbationList", reflect.TypeOf((*MockCommittee)(nil).PutProbationList), list)
+}
+
+// ProbationListByEpoch mocks base method
+func (m *MockCommittee) ProbationListByEpoch(epoch uint64) (*types.ProbationList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProbationListByEpoch", epoch)
+	ret0, _ := ret[0].(*types.ProbationList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProbationListByEpoch indicates an expected call of ProbationListByEpoch
+func (mr *MockCommitteeMockRecorder) ProbationListByEpoch(epoch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProbationListByEpoch", reflect.TypeOf((*MockCommittee)(nil).ProbationListByEpoch), epoch)
+}
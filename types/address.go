@@ -0,0 +1,68 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; 
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-election/util"
+)
+
+// ErrMalformedAddress indicates that an address is neither a valid io1 nor hex address
+var ErrMalformedAddress = errors.New("malformed address")
+
+// ParseRegisteredAddress parses an operator or reward address registered on gravity chain, which is
+// the io1 string padded with zeros. It returns nil for an unregistered address, i.e., all zeros.
+func ParseRegisteredAddress(registered []byte) (address.Address, error) {
+	if util.IsAllZeros(registered) {
+		return nil, nil
+	}
+	addr, err := address.FromString(string(bytes.TrimRight(registered, "\x00")))
+	if err != nil {
+		return nil, errors.Wrapf(ErrMalformedAddress, "%q is not an io1 address", registered)
+	}
+	return addr, nil
+}
+
+// IoAddress converts a gravity chain address into an io1 address
+func IoAddress(addr []byte) (address.Address, error) {
+	ioAddr, err := address.FromBytes(addr)
+	if err != nil {
+		return nil, errors.Wrapf(ErrMalformedAddress, "%x is not an address", addr)
+	}
+	return ioAddr, nil
+}
+
+// DecodeAddress decodes an address in either io1 or hex form, with or without 0x prefix, into the
+// 20 bytes of the gravity chain address
+func DecodeAddress(s string) ([]byte, error) {
+	if strings.HasPrefix(s, address.MainnetPrefix) || strings.HasPrefix(s, address.TestnetPrefix) {
+		addr, err := address.FromString(s)
+		if err != nil {
+			return nil, errors.Wrapf(ErrMalformedAddress, "%s is not an io1 address", s)
+		}
+		return addr.Bytes(), nil
+	}
+	addr, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"))
+	if err != nil {
+		return nil, errors.Wrapf(ErrMalformedAddress, "%s is not a hex address", s)
+	}
+	// an address is of 20 bytes, which is checked by converting it into an io1 address
+	if _, err := address.FromBytes(addr); err != nil {
+		return nil, errors.Wrapf(ErrMalformedAddress, "%s is not of the length of an address", s)
+	}
+	return addr, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; 
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddress(t *testing.T) {
+	require := require.New(t)
+	ioAddr := "io1kfpsvefk74cqxd245j2h5t2pld2wtxzyg6tqrt"
+
+	t.Run("registered", func(t *testing.T) {
+		// registered on gravity chain as two bytes32
+		registered := make([]byte, 41)
+		copy(registered, ioAddr)
+		addr, err := ParseRegisteredAddress(registered)
+		require.NoError(err)
+		require.Equal(ioAddr, addr.String())
+		addr, err = ParseRegisteredAddress(append([]byte(ioAddr), 0, 0))
+		require.NoError(err)
+		require.Equal(ioAddr, addr.String())
+		addr, err = ParseRegisteredAddress(make([]byte, 41))
+		require.NoError(err)
+		require.Nil(addr)
+		_, err = ParseRegisteredAddress([]byte("io1kfpsvefk74cqxd245j2h5t2pld2wtxzyg6tqrx"))
+		require.Equal(ErrMalformedAddress, errors.Cause(err))
+	})
+
+	t.Run("candidate", func(t *testing.T) {
+		c := NewCandidate([]byte("name"), make([]byte, 20), []byte(ioAddr), make([]byte, 41), 1)
+		require.NoError(c.MalformedAddresses())
+		require.Equal(ioAddr, c.OperatorIoAddress().String())
+		require.Nil(c.RewardIoAddress())
+		addr, err := c.IoAddress()
+		require.NoError(err)
+		require.Equal(make([]byte, 20), addr.Bytes())
+
+		malformed := NewCandidate([]byte("name"), make([]byte, 20), []byte(ioAddr), []byte("not an address"), 1)
+		require.Equal(ErrMalformedAddress, errors.Cause(malformed.MalformedAddresses()))
+		require.Nil(malformed.RewardIoAddress())
+		require.Equal(ioAddr, malformed.OperatorIoAddress().String())
+		// the registration is kept as is
+		require.Equal([]byte("not an address"), malformed.RewardAddress())
+
+		cPb, err := malformed.ToProtoMsg()
+		require.NoError(err)
+		clone := &Candidate{}
+		require.NoError(clone.FromProtoMsg(cPb))
+		require.Equal(ioAddr, clone.OperatorIoAddress().String())
+		require.Error(clone.MalformedAddresses())
+		require.True(clone.equal(malformed))
+	})
+
+	t.Run("decode", func(t *testing.T) {
+		addr, err := DecodeAddress(ioAddr)
+		require.NoError(err)
+		hexAddr := hex.EncodeToString(addr)
+		for _, s := range []string{hexAddr, "0x" + hexAddr} {
+			decoded, err := DecodeAddress(s)
+			require.NoError(err)
+			require.Equal(addr, decoded)
+		}
+		_, err = DecodeAddress("io1invalid")
+		require.Equal(ErrMalformedAddress, errors.Cause(err))
+		_, err = DecodeAddress("0xzz")
+		require.Equal(ErrMalformedAddress, errors.Cause(err))
+		// hex addresses shorter or longer than 20 bytes
+		_, err = DecodeAddress(hexAddr[:38])
+		require.Equal(ErrMalformedAddress, errors.Cause(err))
+		_, err = DecodeAddress("0x" + hexAddr + "00")
+		require.Equal(ErrMalformedAddress, errors.Cause(err))
+		_, err = DecodeAddress("")
+		require.Equal(ErrMalformedAddress, errors.Cause(err))
+	})
+}
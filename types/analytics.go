@@ -0,0 +1,196 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	pb "github.com/iotexproject/iotex-election/pb/election"
+)
+
+// topVoters are the numbers of the largest voters whose shares of stake are reported
+var topVoters = []uint32{1, 10, 100}
+
+// TopVoterShare is the share of stake of the largest voters
+type TopVoterShare struct {
+	Top   uint32
+	Share float64
+}
+
+// DelegateVoters is the number of unique voters of a delegate
+type DelegateVoters struct {
+	Name         []byte
+	UniqueVoters uint32
+}
+
+// Statistics defines the decentralization and concentration metrics of a result
+type Statistics struct {
+	// NakamotoCoefficient is the minimum number of delegates whose scores add up to more than half
+	// of the total score of the delegates
+	NakamotoCoefficient uint32
+	// VoterGini is the gini coefficient of the stakes of the voters
+	VoterGini float64
+	// TopVoterShares are the shares of stake of the largest voters
+	TopVoterShares []TopVoterShare
+	// DelegateVoters are the numbers of unique voters of the delegates in the order of their ranks
+	DelegateVoters []DelegateVoters
+	// SelfStakingShare is the share of stake self-staked by the delegates
+	SelfStakingShare float64
+	// TotalVoters is the number of unique voters
+	TotalVoters uint32
+}
+
+// NewStatistics computes the statistics of a result with votes
+func NewStatistics(r *ElectionResult) (*Statistics, error) {
+	if r.Pruned() {
+		return nil, errors.New("Cannot compute statistics of a pruned result")
+	}
+	stats := &Statistics{
+		NakamotoCoefficient: nakamotoCoefficient(r.delegates),
+		DelegateVoters:      make([]DelegateVoters, len(r.delegates)),
+		TopVoterShares:      make([]TopVoterShare, len(topVoters)),
+	}
+	totalStake := big.NewInt(0)
+	selfStake := big.NewInt(0)
+	for i, d := range r.delegates {
+		voters := map[string]bool{}
+		for _, v := range r.VotesByDelegate(d.name) {
+			voters[string(v.voter)] = true
+			totalStake.Add(totalStake, v.amount)
+			if bytes.Equal(v.voter, d.address) {
+				selfStake.Add(selfStake, v.amount)
+			}
+		}
+		stats.DelegateVoters[i] = DelegateVoters{Name: d.Name(), UniqueVoters: uint32(len(voters))}
+	}
+	stats.SelfStakingShare = ratio(selfStake, totalStake)
+
+	r.votersOnce.Do(r.indexVoters)
+	stakes := make([]*big.Int, 0, len(r.voters))
+	for _, votes := range r.voters {
+		stake := big.NewInt(0)
+		for _, v := range votes {
+			stake.Add(stake, v.amount)
+		}
+		stakes = append(stakes, stake)
+	}
+	sort.Slice(stakes, func(i, j int) bool { return stakes[i].Cmp(stakes[j]) < 0 })
+	stats.TotalVoters = uint32(len(stakes))
+	stats.VoterGini = gini(stakes, totalStake)
+	for i, top := range topVoters {
+		topStake := big.NewInt(0)
+		for j := 0; j < int(top) && j < len(stakes); j++ {
+			topStake.Add(topStake, stakes[len(stakes)-1-j])
+		}
+		stats.TopVoterShares[i] = TopVoterShare{Top: top, Share: ratio(topStake, totalStake)}
+	}
+
+	return stats, nil
+}
+
+func nakamotoCoefficient(delegates []*Candidate) uint32 {
+	scores := make([]*big.Int, len(delegates))
+	total := big.NewInt(0)
+	for i, d := range delegates {
+		scores[i] = d.score
+		total.Add(total, d.score)
+	}
+	sort.Slice(scores, func(i, j int) bool { return scores[i].Cmp(scores[j]) > 0 })
+	sum := big.NewInt(0)
+	for i, score := range scores {
+		sum.Add(sum, score)
+		if new(big.Int).Lsh(sum, 1).Cmp(total) > 0 {
+			return uint32(i + 1)
+		}
+	}
+	return 0
+}
+
+// gini computes the gini coefficient of stakes x_1, ..., x_n sorted in ascending order as
+// 2 * sum(i * x_i) / (n * sum(x_i)) - (n + 1) / n
+func gini(stakes []*big.Int, total *big.Int) float64 {
+	n := int64(len(stakes))
+	if n == 0 || total.Sign() == 0 {
+		return 0
+	}
+	weighted := big.NewInt(0)
+	for i, stake := range stakes {
+		weighted.Add(weighted, new(big.Int).Mul(big.NewInt(int64(i+1)), stake))
+	}
+	g := ratio(new(big.Int).Lsh(weighted, 1), new(big.Int).Mul(big.NewInt(n), total))
+
+	return g - float64(n+1)/float64(n)
+}
+
+func ratio(a, b *big.Int) float64 {
+	if b.Sign() == 0 {
+		return 0
+	}
+	r, _ := new(big.Float).Quo(new(big.Float).SetInt(a), new(big.Float).SetInt(b)).Float64()
+	return r
+}
+
+// ToProtoMsg converts the statistics to protobuf
+func (s *Statistics) ToProtoMsg() *pb.Statistics {
+	sPb := &pb.Statistics{
+		NakamotoCoefficient: s.NakamotoCoefficient,
+		VoterGini:           s.VoterGini,
+		TopVoterShares:      make([]*pb.TopVoterShare, len(s.TopVoterShares)),
+		DelegateVoters:      make([]*pb.DelegateVoters, len(s.DelegateVoters)),
+		SelfStakingShare:    s.SelfStakingShare,
+		TotalVoters:         s.TotalVoters,
+	}
+	for i, share := range s.TopVoterShares {
+		sPb.TopVoterShares[i] = &pb.TopVoterShare{Top: share.Top, Share: share.Share}
+	}
+	for i, voters := range s.DelegateVoters {
+		sPb.DelegateVoters[i] = &pb.DelegateVoters{Name: voters.Name, UniqueVoters: voters.UniqueVoters}
+	}
+
+	return sPb
+}
+
+// Serialize converts the statistics to byte array
+func (s *Statistics) Serialize() ([]byte, error) {
+	return proto.Marshal(s.ToProtoMsg())
+}
+
+// FromProtoMsg extracts statistics from protobuf message
+func (s *Statistics) FromProtoMsg(sPb *pb.Statistics) {
+	s.NakamotoCoefficient = sPb.NakamotoCoefficient
+	s.VoterGini = sPb.VoterGini
+	s.TopVoterShares = make([]TopVoterShare, len(sPb.TopVoterShares))
+	for i, share := range sPb.TopVoterShares {
+		s.TopVoterShares[i] = TopVoterShare{Top: share.Top, Share: share.Share}
+	}
+	s.DelegateVoters = make([]DelegateVoters, len(sPb.DelegateVoters))
+	for i, voters := range sPb.DelegateVoters {
+		s.DelegateVoters[i] = DelegateVoters{Name: voters.Name, UniqueVoters: voters.UniqueVoters}
+	}
+	s.SelfStakingShare = sPb.SelfStakingShare
+	s.TotalVoters = sPb.TotalVoters
+}
+
+// Deserialize converts a byte array to statistics
+func (s *Statistics) Deserialize(data []byte) error {
+	sPb := &pb.Statistics{}
+	if err := proto.Unmarshal(data, sPb); err != nil {
+		return err
+	}
+	s.FromProtoMsg(sPb)
+
+	return nil
+}
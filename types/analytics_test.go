@@ -0,0 +1,71 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatistics(t *testing.T) {
+	require := require.New(t)
+	mintTime := time.Now()
+	delegate := func(name string, score int64) *Candidate {
+		c := NewCandidate([]byte(name), []byte(name), nil, nil, 1)
+		c.score = big.NewInt(score)
+		return c
+	}
+	vote := func(voter string, candidate string, amount int64) *Vote {
+		v, err := NewVote(mintTime, 0, big.NewInt(amount), big.NewInt(amount), []byte(voter), []byte(candidate), false)
+		require.NoError(err)
+		return v
+	}
+	result := &ElectionResult{
+		mintTime:  mintTime,
+		delegates: []*Candidate{delegate("a", 50), delegate("b", 30), delegate("c", 20)},
+		votes: map[string][]*Vote{
+			hex.EncodeToString([]byte("a")): {vote("a", "a", 10), vote("x", "a", 30)},
+			hex.EncodeToString([]byte("b")): {vote("x", "b", 20), vote("y", "b", 10)},
+			hex.EncodeToString([]byte("c")): {vote("z", "c", 30)},
+		},
+		totalVotes:       big.NewInt(100),
+		totalVotedStakes: big.NewInt(100),
+	}
+	stats, err := NewStatistics(result)
+	require.NoError(err)
+	require.Equal(uint32(2), stats.NakamotoCoefficient)
+	require.InDelta(0.35, stats.VoterGini, 1e-9)
+	require.Equal([]TopVoterShare{{1, 0.5}, {10, 1}, {100, 1}}, stats.TopVoterShares)
+	require.Equal([]DelegateVoters{
+		{[]byte("a"), 2},
+		{[]byte("b"), 2},
+		{[]byte("c"), 1},
+	}, stats.DelegateVoters)
+	require.InDelta(0.1, stats.SelfStakingShare, 1e-9)
+	require.Equal(uint32(4), stats.TotalVoters)
+
+	data, err := stats.Serialize()
+	require.NoError(err)
+	clone := &Statistics{}
+	require.NoError(clone.Deserialize(data))
+	require.Equal(stats, clone)
+
+	_, err = NewStatistics(result.Summary())
+	require.Error(err)
+	empty, err := NewStatistics(&ElectionResult{votes: map[string][]*Vote{}})
+	require.NoError(err)
+	require.Equal(uint32(0), empty.NakamotoCoefficient)
+	require.Equal(float64(0), empty.VoterGini)
+}
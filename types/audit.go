@@ -0,0 +1,211 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"bytes"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	pb "github.com/iotexproject/iotex-election/pb/election"
+)
+
+// ExclusionReason defines the reason why a candidate or a vote is excluded from the result
+type ExclusionReason int32
+
+const (
+	// ManifiedCandidate stands for a manified candidate skipped by the calculator
+	ManifiedCandidate = ExclusionReason(pb.ExclusionReason_MANIFIED_CANDIDATE)
+	// FilteredCandidate stands for a candidate failing the candidate filter
+	FilteredCandidate = ExclusionReason(pb.ExclusionReason_FILTERED_CANDIDATE)
+	// LowScoreCandidate stands for a candidate below the score threshold
+	LowScoreCandidate = ExclusionReason(pb.ExclusionReason_LOW_SCORE_CANDIDATE)
+	// LowSelfStakingCandidate stands for a candidate below the self staking threshold
+	LowSelfStakingCandidate = ExclusionReason(pb.ExclusionReason_LOW_SELF_STAKING_CANDIDATE)
+	// FilteredVote stands for a vote failing the vote filter
+	FilteredVote = ExclusionReason(pb.ExclusionReason_FILTERED_VOTE)
+	// ZeroCandidateVote stands for a vote for the zero candidate or no candidate
+	ZeroCandidateVote = ExclusionReason(pb.ExclusionReason_ZERO_CANDIDATE_VOTE)
+	// UnknownCandidateVote stands for a vote for a candidate not registered or skipped
+	UnknownCandidateVote = ExclusionReason(pb.ExclusionReason_UNKNOWN_CANDIDATE_VOTE)
+	// ExcludedCandidateVote stands for a vote for a candidate excluded from the result
+	ExcludedCandidateVote = ExclusionReason(pb.ExclusionReason_EXCLUDED_CANDIDATE_VOTE)
+)
+
+func (r ExclusionReason) String() string {
+	return pb.ExclusionReason(r).String()
+}
+
+// ExcludedCandidate defines a candidate excluded from the result
+type ExcludedCandidate struct {
+	Candidate *Candidate
+	Reason    ExclusionReason
+}
+
+// ExcludedVote defines a vote excluded from the result
+type ExcludedVote struct {
+	Vote   *Vote
+	Reason ExclusionReason
+}
+
+// ExclusionAudit records the candidates and votes excluded when calculating a result
+type ExclusionAudit struct {
+	candidates []ExcludedCandidate
+	votes      []ExcludedVote
+	malformed  []*Candidate
+	pruned     bool
+}
+
+// Candidates returns the excluded candidates
+func (a *ExclusionAudit) Candidates() []ExcludedCandidate {
+	return a.candidates
+}
+
+// Votes returns the excluded votes
+func (a *ExclusionAudit) Votes() []ExcludedVote {
+	return a.votes
+}
+
+// Malformed returns the candidates registered with malformed addresses, which are not excluded
+func (a *ExclusionAudit) Malformed() []*Candidate {
+	return a.malformed
+}
+
+// CandidateByName returns the exclusion of a candidate, or nil if it is not excluded
+func (a *ExclusionAudit) CandidateByName(name []byte) *ExcludedCandidate {
+	for i, c := range a.candidates {
+		if bytes.Equal(c.Candidate.Name(), name) {
+			return &a.candidates[i]
+		}
+	}
+	return nil
+}
+
+// VotesByCandidate returns the excluded votes for a candidate
+func (a *ExclusionAudit) VotesByCandidate(name []byte) []ExcludedVote {
+	votes := []ExcludedVote{}
+	for _, v := range a.votes {
+		if bytes.Equal(v.Vote.Candidate(), name) {
+			votes = append(votes, v)
+		}
+	}
+	return votes
+}
+
+// Pruned returns true if the excluded votes have been pruned
+func (a *ExclusionAudit) Pruned() bool {
+	return a.pruned
+}
+
+// Summary returns a copy of the audit with excluded candidates only
+func (a *ExclusionAudit) Summary() *ExclusionAudit {
+	return &ExclusionAudit{
+		candidates: a.candidates,
+		votes:      []ExcludedVote{},
+		malformed:  a.malformed,
+		pruned:     true,
+	}
+}
+
+// ToProtoMsg converts the audit to protobuf
+func (a *ExclusionAudit) ToProtoMsg() (*pb.ExclusionAudit, error) {
+	aPb := &pb.ExclusionAudit{
+		Candidates: make([]*pb.ExcludedCandidate, len(a.candidates)),
+		Votes:      make([]*pb.ExcludedVote, len(a.votes)),
+		Malformed:  make([]*pb.Candidate, len(a.malformed)),
+		Pruned:     a.pruned,
+	}
+	for i, c := range a.candidates {
+		cPb, err := c.Candidate.ToProtoMsg()
+		if err != nil {
+			return nil, err
+		}
+		aPb.Candidates[i] = &pb.ExcludedCandidate{
+			Candidate: cPb,
+			Reason:    pb.ExclusionReason(c.Reason),
+		}
+	}
+	for i, v := range a.votes {
+		vPb, err := v.Vote.ToProtoMsg()
+		if err != nil {
+			return nil, err
+		}
+		aPb.Votes[i] = &pb.ExcludedVote{
+			Vote:   vPb,
+			Reason: pb.ExclusionReason(v.Reason),
+		}
+	}
+	for i, c := range a.malformed {
+		cPb, err := c.ToProtoMsg()
+		if err != nil {
+			return nil, err
+		}
+		aPb.Malformed[i] = cPb
+	}
+
+	return aPb, nil
+}
+
+// Serialize converts the audit to byte array
+func (a *ExclusionAudit) Serialize() ([]byte, error) {
+	aPb, err := a.ToProtoMsg()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(aPb)
+}
+
+// FromProtoMsg extracts audit details from protobuf message
+func (a *ExclusionAudit) FromProtoMsg(aPb *pb.ExclusionAudit) error {
+	a.candidates = make([]ExcludedCandidate, len(aPb.Candidates))
+	for i, cPb := range aPb.Candidates {
+		if cPb.Candidate == nil {
+			return errors.Wrap(ErrInvalidProto, "excluded candidate is missing")
+		}
+		c := &Candidate{}
+		if err := c.FromProtoMsg(cPb.Candidate); err != nil {
+			return err
+		}
+		a.candidates[i] = ExcludedCandidate{Candidate: c, Reason: ExclusionReason(cPb.Reason)}
+	}
+	a.votes = make([]ExcludedVote, len(aPb.Votes))
+	for i, vPb := range aPb.Votes {
+		if vPb.Vote == nil {
+			return errors.Wrap(ErrInvalidProto, "excluded vote is missing")
+		}
+		v := &Vote{}
+		if err := v.FromProtoMsg(vPb.Vote); err != nil {
+			return err
+		}
+		a.votes[i] = ExcludedVote{Vote: v, Reason: ExclusionReason(vPb.Reason)}
+	}
+	a.malformed = make([]*Candidate, len(aPb.Malformed))
+	for i, cPb := range aPb.Malformed {
+		a.malformed[i] = &Candidate{}
+		if err := a.malformed[i].FromProtoMsg(cPb); err != nil {
+			return err
+		}
+	}
+	a.pruned = aPb.Pruned
+
+	return nil
+}
+
+// Deserialize converts a byte array to audit
+func (a *ExclusionAudit) Deserialize(data []byte) error {
+	aPb := &pb.ExclusionAudit{}
+	if err := proto.Unmarshal(data, aPb); err != nil {
+		return err
+	}
+
+	return a.FromProtoMsg(aPb)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExclusionAudit(t *testing.T) {
+	require := require.New(t)
+	mintTime := time.Unix(1559220700, 0)
+	vote := func(voter string, candidate []byte, amount int64) *Vote {
+		v, err := NewVote(mintTime, 0, big.NewInt(amount), big.NewInt(0), []byte(voter), candidate, false)
+		require.NoError(err)
+		return v
+	}
+	calculator := NewResultCalculator(
+		mintTime,
+		true,
+		mockVoteFilter(10),
+		mockCalcWeight,
+		mockCandidateFilter(100, 0),
+	)
+	_, err := calculator.Audit()
+	require.Error(err)
+	require.NoError(calculator.AddCandidates([]*Candidate{
+		NewCandidate([]byte("qualified"), []byte("a"), nil, []byte("not an address"), 1),
+		NewCandidate([]byte("poor"), []byte("b"), nil, nil, 1),
+		NewCandidate([]byte("manified"), []byte("c"), nil, nil, 2),
+	}))
+	require.NoError(calculator.AddVotes([]*Vote{
+		vote("v1", []byte("qualified"), 200),
+		vote("v2", []byte("qualified"), 5),
+		vote("v3", []byte("poor"), 50),
+		vote("v4", []byte("manified"), 50),
+		vote("v5", make([]byte, 12), 50),
+		vote("v6", []byte("nobody"), 50),
+	}))
+	result, err := calculator.Calculate()
+	require.NoError(err)
+	require.Equal(1, len(result.Delegates()))
+	audit, err := calculator.Audit()
+	require.NoError(err)
+
+	candidates := audit.Candidates()
+	require.Equal(2, len(candidates))
+	require.Equal([]byte("manified"), candidates[0].Candidate.Name())
+	require.Equal(ManifiedCandidate, candidates[0].Reason)
+	require.Equal([]byte("poor"), candidates[1].Candidate.Name())
+	require.Equal(FilteredCandidate, candidates[1].Reason)
+	require.Equal(0, big.NewInt(50).Cmp(candidates[1].Candidate.Score()))
+	require.Equal("FILTERED_CANDIDATE", candidates[1].Reason.String())
+
+	reasons := map[string]ExclusionReason{}
+	for _, v := range audit.Votes() {
+		reasons[string(v.Vote.Voter())] = v.Reason
+	}
+	require.Equal(map[string]ExclusionReason{
+		"v2": FilteredVote,
+		"v3": ExcludedCandidateVote,
+		"v4": UnknownCandidateVote,
+		"v5": ZeroCandidateVote,
+		"v6": UnknownCandidateVote,
+	}, reasons)
+	require.NotNil(audit.CandidateByName([]byte("poor")))
+	require.Nil(audit.CandidateByName([]byte("qualified")))
+	require.Equal(1, len(audit.VotesByCandidate([]byte("qualified"))))
+	// a malformed registration is recorded without being excluded
+	require.Equal(1, len(audit.Malformed()))
+	require.Equal([]byte("qualified"), audit.Malformed()[0].Name())
+	require.Equal([]byte("qualified"), result.Delegates()[0].Name())
+
+	data, err := audit.Serialize()
+	require.NoError(err)
+	clone := &ExclusionAudit{}
+	require.NoError(clone.Deserialize(data))
+	require.Equal(len(audit.Votes()), len(clone.Votes()))
+	require.Equal(ExcludedCandidateVote, clone.Votes()[len(clone.Votes())-1].Reason)
+	require.True(clone.Candidates()[1].Candidate.equal(candidates[1].Candidate))
+	require.False(clone.Pruned())
+	require.Equal(1, len(clone.Malformed()))
+	require.True(clone.Malformed()[0].equal(audit.Malformed()[0]))
+
+	summary := clone.Summary()
+	require.True(summary.Pruned())
+	require.Equal(2, len(summary.Candidates()))
+	require.Equal(0, len(summary.Votes()))
+	require.Equal(1, len(summary.Malformed()))
+
+	// the reasons of the exclusion function are recorded instead
+	calculator = NewResultCalculatorWithExclusion(
+		mintTime,
+		true,
+		mockVoteFilter(10),
+		mockCalcWeight,
+		ThresholdFilter(big.NewInt(100), big.NewInt(0)),
+	)
+	require.NoError(calculator.AddCandidates([]*Candidate{
+		NewCandidate([]byte("qualified"), []byte("a"), nil, nil, 1),
+		NewCandidate([]byte("poor"), []byte("b"), nil, nil, 1),
+	}))
+	require.NoError(calculator.AddVotes([]*Vote{
+		vote("v1", []byte("qualified"), 200),
+		vote("v3", []byte("poor"), 50),
+	}))
+	_, err = calculator.Calculate()
+	require.NoError(err)
+	audit, err = calculator.Audit()
+	require.NoError(err)
+	require.Equal(1, len(audit.Candidates()))
+	require.Equal(LowScoreCandidate, audit.Candidates()[0].Reason)
+}
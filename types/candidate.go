@@ -12,9 +12,12 @@ package types
 
 import (
 	"bytes"
-	"errors"
 	"math/big"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
 	pb "github.com/iotexproject/iotex-election/pb/election"
 	"github.com/iotexproject/iotex-election/util"
 )
@@ -25,12 +28,15 @@ type Candidate struct {
 	address           []byte
 	operatorAddress   []byte
 	rewardAddress     []byte
+	operatorIoAddress address.Address
+	rewardIoAddress   address.Address
 	score             *big.Int
 	selfStakingTokens *big.Int
 	selfStakingWeight uint64
 }
 
-// NewCandidate creates a new candidate with scores as 0s
+// NewCandidate creates a new candidate with scores as 0s. The operator and reward addresses are the
+// io1 addresses registered on gravity chain, which are flagged as malformed if invalid
 func NewCandidate(
 	name []byte,
 	address []byte,
@@ -38,7 +44,7 @@ func NewCandidate(
 	rewardPubKey []byte,
 	selfStakingWeight uint64,
 ) *Candidate {
-	return &Candidate{
+	c := &Candidate{
 		name:              util.CopyBytes(name),
 		address:           util.CopyBytes(address),
 		operatorAddress:   util.CopyBytes(operatorAddress),
@@ -47,6 +53,14 @@ func NewCandidate(
 		selfStakingTokens: big.NewInt(0),
 		selfStakingWeight: selfStakingWeight,
 	}
+	return c.parseAddresses()
+}
+
+// parseAddresses parses the registered operator and reward addresses, leaving the malformed ones nil
+func (c *Candidate) parseAddresses() *Candidate {
+	c.operatorIoAddress, _ = ParseRegisteredAddress(c.operatorAddress)
+	c.rewardIoAddress, _ = ParseRegisteredAddress(c.rewardAddress)
+	return c
 }
 
 // Clone clones the candidate
@@ -56,6 +70,8 @@ func (c *Candidate) Clone() *Candidate {
 		address:           c.Address(),
 		operatorAddress:   c.OperatorAddress(),
 		rewardAddress:     c.RewardAddress(),
+		operatorIoAddress: c.operatorIoAddress,
+		rewardIoAddress:   c.rewardIoAddress,
 		score:             c.Score(),
 		selfStakingTokens: c.SelfStakingTokens(),
 		selfStakingWeight: c.SelfStakingWeight(),
@@ -90,6 +106,13 @@ func (c *Candidate) equal(candidate *Candidate) bool {
 	return c.selfStakingWeight == candidate.selfStakingWeight
 }
 
+func (c *Candidate) estimatedSize() uint64 {
+	return candidateOverhead +
+		uint64(len(c.name)+len(c.address)+len(c.operatorAddress)+len(c.rewardAddress)) +
+		bigIntSize(c.score) +
+		bigIntSize(c.selfStakingTokens)
+}
+
 func (c *Candidate) reset() *Candidate {
 	c.selfStakingTokens.SetInt64(0)
 	c.score.SetInt64(0)
@@ -132,6 +155,32 @@ func (c *Candidate) RewardAddress() []byte {
 	return util.CopyBytes(c.rewardAddress)
 }
 
+// OperatorIoAddress returns the operator address, or nil if it is unregistered or malformed
+func (c *Candidate) OperatorIoAddress() address.Address {
+	return c.operatorIoAddress
+}
+
+// RewardIoAddress returns the reward address, or nil if it is unregistered or malformed
+func (c *Candidate) RewardIoAddress() address.Address {
+	return c.rewardIoAddress
+}
+
+// IoAddress returns the io1 form of the address on gravity chain
+func (c *Candidate) IoAddress() (address.Address, error) {
+	return IoAddress(c.address)
+}
+
+// MalformedAddresses returns an error if the registered operator or reward address is malformed
+func (c *Candidate) MalformedAddresses() error {
+	if _, err := ParseRegisteredAddress(c.operatorAddress); err != nil {
+		return errors.Wrap(err, "invalid operator address")
+	}
+	if _, err := ParseRegisteredAddress(c.rewardAddress); err != nil {
+		return errors.Wrap(err, "invalid reward address")
+	}
+	return nil
+}
+
 // Score returns the total votes (weighted) of this candidate
 func (c *Candidate) Score() *big.Int {
 	return new(big.Int).Set(c.score)
@@ -179,6 +228,26 @@ func (c *Candidate) FromProtoMsg(msg *pb.Candidate) error {
 	c.score = new(big.Int).SetBytes(msg.GetScore())
 	c.selfStakingTokens = new(big.Int).SetBytes(msg.GetSelfStakingTokens())
 	c.selfStakingWeight = msg.GetSelfStakingWeight()
+	c.parseAddresses()
 
 	return nil
 }
+
+// Serialize serializes the candidate to bytes
+func (c *Candidate) Serialize() ([]byte, error) {
+	cPb, err := c.ToProtoMsg()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(cPb)
+}
+
+// Deserialize deserializes a byte array to candidate
+func (c *Candidate) Deserialize(data []byte) error {
+	cPb := &pb.Candidate{}
+	if err := proto.Unmarshal(data, cPb); err != nil {
+		return err
+	}
+
+	return c.FromProtoMsg(cPb)
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// CandidateCSVHeader is the header of the CSV encoding of candidates
+	CandidateCSVHeader = []string{
+		"name",
+		"nameHex",
+		"address",
+		"ioAddress",
+		"operatorAddress",
+		"rewardAddress",
+		"selfStakingWeight",
+		"score",
+		"selfStakingTokens",
+	}
+	// VoteCSVHeader is the header of the CSV encoding of votes
+	VoteCSVHeader = []string{
+		"voter",
+		"voterIoAddress",
+		"candidate",
+		"candidateHex",
+		"amount",
+		"weightedAmount",
+		"startTime",
+		"duration",
+		"decay",
+	}
+)
+
+// WriteCandidatesCSV writes the candidates as CSV with CandidateCSVHeader as the first row
+func WriteCandidatesCSV(w io.Writer, candidates []*Candidate) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(CandidateCSVHeader); err != nil {
+		return err
+	}
+	for _, c := range candidates {
+		cJSON := c.toJSON()
+		if err := writer.Write([]string{
+			cJSON.Name,
+			cJSON.NameHex,
+			cJSON.Address,
+			cJSON.IoAddress,
+			cJSON.OperatorAddress,
+			cJSON.RewardAddress,
+			strconv.FormatUint(cJSON.SelfStakingWeight, 10),
+			cJSON.Score,
+			cJSON.SelfStakingTokens,
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// ReadCandidatesCSV reads the candidates written by WriteCandidatesCSV
+func ReadCandidatesCSV(r io.Reader) ([]*Candidate, error) {
+	records, err := readCSV(r, CandidateCSVHeader)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]*Candidate, len(records))
+	for i, record := range records {
+		weight, err := strconv.ParseUint(record[6], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid self staking weight in row %d", i+1)
+		}
+		candidates[i] = &Candidate{}
+		if err := candidates[i].fromJSON(&candidateJSON{
+			Name:              record[0],
+			NameHex:           record[1],
+			Address:           record[2],
+			IoAddress:         record[3],
+			OperatorAddress:   record[4],
+			RewardAddress:     record[5],
+			SelfStakingWeight: weight,
+			Score:             record[7],
+			SelfStakingTokens: record[8],
+		}); err != nil {
+			return nil, errors.Wrapf(err, "invalid candidate in row %d", i+1)
+		}
+	}
+
+	return candidates, nil
+}
+
+// WriteVotesCSV writes the votes as CSV with VoteCSVHeader as the first row
+func WriteVotesCSV(w io.Writer, votes []*Vote) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(VoteCSVHeader); err != nil {
+		return err
+	}
+	for _, v := range votes {
+		vJSON := v.toJSON()
+		if err := writer.Write([]string{
+			vJSON.Voter,
+			vJSON.VoterIoAddress,
+			vJSON.Candidate,
+			vJSON.CandidateHex,
+			vJSON.Amount,
+			vJSON.WeightedAmount,
+			vJSON.StartTime,
+			vJSON.Duration,
+			strconv.FormatBool(vJSON.Decay),
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// ReadVotesCSV reads the votes written by WriteVotesCSV
+func ReadVotesCSV(r io.Reader) ([]*Vote, error) {
+	records, err := readCSV(r, VoteCSVHeader)
+	if err != nil {
+		return nil, err
+	}
+	votes := make([]*Vote, len(records))
+	for i, record := range records {
+		decay, err := strconv.ParseBool(record[8])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid decay in row %d", i+1)
+		}
+		votes[i] = &Vote{}
+		if err := votes[i].fromJSON(&voteJSON{
+			Voter:          record[0],
+			VoterIoAddress: record[1],
+			Candidate:      record[2],
+			CandidateHex:   record[3],
+			Amount:         record[4],
+			WeightedAmount: record[5],
+			StartTime:      record[6],
+			Duration:       record[7],
+			Decay:          decay,
+		}); err != nil {
+			return nil, errors.Wrapf(err, "invalid vote in row %d", i+1)
+		}
+	}
+
+	return votes, nil
+}
+
+// readCSV reads the records after checking the header
+func readCSV(r io.Reader, header []string) ([][]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(header)
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("header is missing")
+	}
+	for i, field := range header {
+		if records[0][i] != field {
+			return nil, errors.Errorf("unexpected column %s, expecting %s", records[0][i], field)
+		}
+	}
+
+	return records[1:], nil
+}
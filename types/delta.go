@@ -0,0 +1,208 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	pb "github.com/iotexproject/iotex-election/pb/election"
+)
+
+// voteKey identifies a vote regardless of its weighted amount, which changes over time
+func voteKey(v *Vote) string {
+	return fmt.Sprintf(
+		"%x/%x/%d/%d/%s/%t",
+		v.voter,
+		v.candidate,
+		v.startTime.UnixNano(),
+		int64(v.duration),
+		v.amount.Text(16),
+		v.decay,
+	)
+}
+
+// DeltaProtoMsg encodes the result against a base result, such that the votes also in the base
+// result are stored as runs of positions in the base result
+func (r *ElectionResult) DeltaProtoMsg(base *ElectionResult) (*pb.ResultDelta, error) {
+	if r.pruned || base.pruned {
+		return nil, errors.New("Cannot encode a delta with a pruned result")
+	}
+	t, err := ptypes.TimestampProto(r.mintTime)
+	if err != nil {
+		return nil, err
+	}
+	dPb := &pb.ResultDelta{
+		Timestamp:        t,
+		Delegates:        make([]*pb.Candidate, len(r.delegates)),
+		DelegateVotes:    make([]*pb.VoteListDelta, len(r.delegates)),
+		TotalVotedStakes: r.totalVotedStakes.Bytes(),
+		TotalVotes:       r.totalVotes.Bytes(),
+	}
+	for i, d := range r.delegates {
+		if dPb.Delegates[i], err = d.ToProtoMsg(); err != nil {
+			return nil, err
+		}
+		name := hex.EncodeToString(d.name)
+		votes, ok := r.votes[name]
+		if !ok {
+			return nil, errors.Errorf("Cannot find votes for delegate %s", name)
+		}
+		if dPb.DelegateVotes[i], err = voteListDelta(base.votes[name], votes); err != nil {
+			return nil, err
+		}
+	}
+
+	return dPb, nil
+}
+
+func voteListDelta(baseVotes []*Vote, votes []*Vote) (*pb.VoteListDelta, error) {
+	positions := map[string][]int{}
+	for i, v := range baseVotes {
+		key := voteKey(v)
+		positions[key] = append(positions[key], i)
+	}
+	delta := &pb.VoteListDelta{}
+	var run *pb.VoteRun
+	last := -1
+	for i, v := range votes {
+		// find the first unused position of the vote in the base after the last copied one
+		match := -1
+		key := voteKey(v)
+		for len(positions[key]) > 0 {
+			p := positions[key][0]
+			positions[key] = positions[key][1:]
+			if p > last {
+				match = p
+				break
+			}
+		}
+		if match < 0 {
+			vPb, err := v.ToProtoMsg()
+			if err != nil {
+				return nil, err
+			}
+			run = nil
+			delta.Runs = append(delta.Runs, &pb.VoteRun{Vote: vPb})
+			continue
+		}
+		if run != nil && match == last+1 {
+			run.Count++
+		} else {
+			run = &pb.VoteRun{Offset: uint32(match), Count: 1}
+			delta.Runs = append(delta.Runs, run)
+		}
+		last = match
+		if v.weighted.Cmp(baseVotes[match].weighted) != 0 {
+			delta.Reweighted = append(delta.Reweighted, uint32(i))
+			delta.WeightedAmounts = append(delta.WeightedAmounts, v.weighted.Bytes())
+		}
+	}
+
+	return delta, nil
+}
+
+// FromDeltaProtoMsg decodes the result from a delta against the base result
+func (r *ElectionResult) FromDeltaProtoMsg(base *ElectionResult, dPb *pb.ResultDelta) (err error) {
+	if base.pruned {
+		return errors.New("Cannot decode a delta with a pruned base")
+	}
+	if len(dPb.Delegates) != len(dPb.DelegateVotes) {
+		return errors.Wrapf(
+			ErrInvalidProto,
+			"size of delegate list %d is different from vote list %d",
+			len(dPb.Delegates),
+			len(dPb.DelegateVotes),
+		)
+	}
+	r.votes = map[string][]*Vote{}
+	r.delegates = make([]*Candidate, len(dPb.Delegates))
+	for i, cPb := range dPb.Delegates {
+		r.delegates[i] = &Candidate{}
+		if err := r.delegates[i].FromProtoMsg(cPb); err != nil {
+			return err
+		}
+		name := hex.EncodeToString(r.delegates[i].Name())
+		if _, ok := r.votes[name]; ok {
+			return errors.Wrapf(ErrInvalidProto, "duplicate delegate %s", name)
+		}
+		if r.votes[name], err = applyVoteListDelta(base.votes[name], dPb.DelegateVotes[i]); err != nil {
+			return err
+		}
+	}
+	if r.mintTime, err = ptypes.Timestamp(dPb.Timestamp); err != nil {
+		return err
+	}
+	r.totalVotedStakes = new(big.Int).SetBytes(dPb.TotalVotedStakes)
+	r.totalVotes = new(big.Int).SetBytes(dPb.TotalVotes)
+	r.pruned = false
+
+	return nil
+}
+
+func applyVoteListDelta(baseVotes []*Vote, delta *pb.VoteListDelta) ([]*Vote, error) {
+	votes := []*Vote{}
+	for _, run := range delta.Runs {
+		if run.Count == 0 {
+			if run.Vote == nil {
+				return nil, errors.Wrap(ErrInvalidProto, "inserted vote is missing")
+			}
+			v := &Vote{}
+			if err := v.FromProtoMsg(run.Vote); err != nil {
+				return nil, err
+			}
+			votes = append(votes, v)
+			continue
+		}
+		end := uint64(run.Offset) + uint64(run.Count)
+		if end > uint64(len(baseVotes)) {
+			return nil, errors.Wrapf(ErrInvalidProto, "run [%d, %d) is out of base votes", run.Offset, end)
+		}
+		for _, v := range baseVotes[run.Offset:end] {
+			votes = append(votes, v.Clone())
+		}
+	}
+	if len(delta.Reweighted) != len(delta.WeightedAmounts) {
+		return nil, errors.Wrap(ErrInvalidProto, "size of reweighted positions is different from amounts")
+	}
+	for i, p := range delta.Reweighted {
+		if int(p) >= len(votes) {
+			return nil, errors.Wrapf(ErrInvalidProto, "reweighted position %d is out of votes", p)
+		}
+		votes[p].weighted = new(big.Int).SetBytes(delta.WeightedAmounts[i])
+	}
+
+	return votes, nil
+}
+
+// SerializeDelta converts the result to a byte array of the delta against the base result
+func (r *ElectionResult) SerializeDelta(base *ElectionResult) ([]byte, error) {
+	dPb, err := r.DeltaProtoMsg(base)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(dPb)
+}
+
+// DeserializeDelta converts a byte array of the delta against the base result to the result
+func (r *ElectionResult) DeserializeDelta(base *ElectionResult, data []byte) error {
+	dPb := &pb.ResultDelta{}
+	if err := proto.Unmarshal(data, dPb); err != nil {
+		return err
+	}
+
+	return r.FromDeltaProtoMsg(base, dPb)
+}
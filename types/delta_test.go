@@ -0,0 +1,82 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultDelta(t *testing.T) {
+	require := require.New(t)
+	mintTime := time.Now().Add(-10 * time.Hour)
+	calculate := func(mintTime time.Time, votes []*Vote) *ElectionResult {
+		calculator := NewResultCalculator(
+			mintTime,
+			false,
+			mockVoteFilter(10),
+			mockCalcWeight,
+			mockCandidateFilter(0, 0),
+		)
+		require.NoError(calculator.AddCandidates(genTestCandidates()))
+		require.NoError(calculator.AddVotes(votes))
+		result, err := calculator.Calculate()
+		require.NoError(err)
+		return result
+	}
+	votes := genTestVotes(mintTime, require)
+	for i := 0; i < 1000; i++ {
+		v, err := NewVote(
+			mintTime.Add(-time.Duration(i)*time.Minute),
+			time.Duration(i%5)*time.Hour,
+			big.NewInt(int64(100+i)),
+			big.NewInt(0),
+			[]byte{byte(i >> 8), byte(i)},
+			[]byte("candidate2"),
+			i%7 == 0,
+		)
+		require.NoError(err)
+		votes = append(votes, v)
+	}
+	base := calculate(mintTime, votes)
+	extra, err := NewVote(mintTime, time.Hour, big.NewInt(500), big.NewInt(0), []byte("new voter"), []byte("candidate1"), true)
+	require.NoError(err)
+	// one hour later, a bucket is removed, another is inserted, and decay votes are reweighted
+	changed := append([]*Vote{}, votes[:3]...)
+	changed = append(changed, extra)
+	changed = append(changed, votes[4:]...)
+	result := calculate(mintTime.Add(time.Hour), changed)
+
+	data, err := result.SerializeDelta(base)
+	require.NoError(err)
+	decoded := &ElectionResult{}
+	require.NoError(decoded.DeserializeDelta(base, data))
+	expected, err := result.Serialize()
+	require.NoError(err)
+	actual, err := decoded.Serialize()
+	require.NoError(err)
+	require.Equal(expected, actual)
+	require.True(len(data)*10 < len(expected), "delta of %d bytes, full of %d bytes", len(data), len(expected))
+	// decoding does not modify the base
+	baseData, err := base.Serialize()
+	require.NoError(err)
+	require.NoError(decoded.DeserializeDelta(base, data))
+	again, err := base.Serialize()
+	require.NoError(err)
+	require.Equal(baseData, again)
+
+	_, err = result.SerializeDelta(base.Summary())
+	require.Error(err)
+	require.Error(decoded.DeserializeDelta(calculate(mintTime, votes[:1]), data))
+}
@@ -0,0 +1,358 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// The JSON and CSV encodings of candidates, votes and results follow the same conventions:
+//   - amounts are decimal strings
+//   - names are readable text, along with the hex strings of the raw bytes, from which names are decoded
+//   - gravity chain addresses are 0x-prefixed hex strings, along with the io1 addresses of IoTeX
+//   - times are RFC 3339 strings in UTC
+//   - durations are ISO 8601 strings, e.g., P14DT12H
+
+// candidateJSON is the JSON encoding of a candidate
+type candidateJSON struct {
+	Name              string `json:"name"`
+	NameHex           string `json:"nameHex"`
+	Address           string `json:"address"`
+	IoAddress         string `json:"ioAddress"`
+	OperatorAddress   string `json:"operatorAddress"`
+	RewardAddress     string `json:"rewardAddress"`
+	SelfStakingWeight uint64 `json:"selfStakingWeight"`
+	Score             string `json:"score"`
+	SelfStakingTokens string `json:"selfStakingTokens"`
+}
+
+// voteJSON is the JSON encoding of a vote
+type voteJSON struct {
+	Voter          string `json:"voter"`
+	VoterIoAddress string `json:"voterIoAddress"`
+	Candidate      string `json:"candidate"`
+	CandidateHex   string `json:"candidateHex"`
+	Amount         string `json:"amount"`
+	WeightedAmount string `json:"weightedAmount"`
+	StartTime      string `json:"startTime"`
+	Duration       string `json:"duration"`
+	Decay          bool   `json:"decay"`
+}
+
+// delegateJSON is the JSON encoding of a delegate with its votes
+type delegateJSON struct {
+	candidateJSON
+	Votes []*voteJSON `json:"votes"`
+}
+
+// resultJSON is the JSON encoding of a result
+type resultJSON struct {
+	MintTime         string          `json:"mintTime"`
+	TotalVotes       string          `json:"totalVotes"`
+	TotalVotedStakes string          `json:"totalVotedStakes"`
+	Pruned           bool            `json:"pruned"`
+	Delegates        []*delegateJSON `json:"delegates"`
+}
+
+func (c *Candidate) toJSON() *candidateJSON {
+	cJSON := &candidateJSON{
+		Name:              readableName(c.name),
+		NameHex:           hex.EncodeToString(c.name),
+		Address:           hexutil.Encode(c.address),
+		OperatorAddress:   string(c.operatorAddress),
+		RewardAddress:     string(c.rewardAddress),
+		SelfStakingWeight: c.selfStakingWeight,
+		Score:             c.score.Text(10),
+		SelfStakingTokens: c.selfStakingTokens.Text(10),
+	}
+	if addr, err := c.IoAddress(); err == nil {
+		cJSON.IoAddress = addr.String()
+	}
+	return cJSON
+}
+
+func (c *Candidate) fromJSON(cJSON *candidateJSON) (err error) {
+	if c.name, err = hex.DecodeString(cJSON.NameHex); err != nil {
+		return errors.Wrap(err, "invalid name")
+	}
+	if c.address, err = hexutil.Decode(cJSON.Address); err != nil {
+		return errors.Wrap(err, "invalid address")
+	}
+	c.operatorAddress = []byte(cJSON.OperatorAddress)
+	c.rewardAddress = []byte(cJSON.RewardAddress)
+	c.parseAddresses()
+	c.selfStakingWeight = cJSON.SelfStakingWeight
+	if c.score, err = parseAmount(cJSON.Score); err != nil {
+		return errors.Wrap(err, "invalid score")
+	}
+	if c.selfStakingTokens, err = parseAmount(cJSON.SelfStakingTokens); err != nil {
+		return errors.Wrap(err, "invalid self staking tokens")
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the candidate into JSON
+func (c *Candidate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.toJSON())
+}
+
+// UnmarshalJSON decodes the candidate from JSON
+func (c *Candidate) UnmarshalJSON(data []byte) error {
+	cJSON := &candidateJSON{}
+	if err := json.Unmarshal(data, cJSON); err != nil {
+		return err
+	}
+	return c.fromJSON(cJSON)
+}
+
+func (v *Vote) toJSON() *voteJSON {
+	vJSON := &voteJSON{
+		Voter:          hexutil.Encode(v.voter),
+		Candidate:      readableName(v.candidate),
+		CandidateHex:   hex.EncodeToString(v.candidate),
+		Amount:         v.amount.Text(10),
+		WeightedAmount: v.weighted.Text(10),
+		StartTime:      v.startTime.UTC().Format(time.RFC3339Nano),
+		Duration:       FormatISODuration(v.duration),
+		Decay:          v.decay,
+	}
+	if addr, err := IoAddress(v.voter); err == nil {
+		vJSON.VoterIoAddress = addr.String()
+	}
+	return vJSON
+}
+
+func (v *Vote) fromJSON(vJSON *voteJSON) error {
+	voter, err := hexutil.Decode(vJSON.Voter)
+	if err != nil {
+		return errors.Wrap(err, "invalid voter")
+	}
+	candidate, err := hex.DecodeString(vJSON.CandidateHex)
+	if err != nil {
+		return errors.Wrap(err, "invalid candidate")
+	}
+	amount, err := parseAmount(vJSON.Amount)
+	if err != nil {
+		return errors.Wrap(err, "invalid amount")
+	}
+	weighted, err := parseAmount(vJSON.WeightedAmount)
+	if err != nil {
+		return errors.Wrap(err, "invalid weighted amount")
+	}
+	startTime, err := time.Parse(time.RFC3339, vJSON.StartTime)
+	if err != nil {
+		return errors.Wrap(err, "invalid start time")
+	}
+	duration, err := ParseISODuration(vJSON.Duration)
+	if err != nil {
+		return err
+	}
+	vote, err := NewVote(startTime, duration, amount, weighted, voter, candidate, vJSON.Decay)
+	if err != nil {
+		return err
+	}
+	*v = *vote
+
+	return nil
+}
+
+// MarshalJSON encodes the vote into JSON
+func (v *Vote) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.toJSON())
+}
+
+// UnmarshalJSON decodes the vote from JSON
+func (v *Vote) UnmarshalJSON(data []byte) error {
+	vJSON := &voteJSON{}
+	if err := json.Unmarshal(data, vJSON); err != nil {
+		return err
+	}
+	return v.fromJSON(vJSON)
+}
+
+// MarshalJSON encodes the result into JSON, in which the votes are listed under their delegates
+func (r *ElectionResult) MarshalJSON() ([]byte, error) {
+	rJSON := &resultJSON{
+		MintTime:         r.mintTime.UTC().Format(time.RFC3339Nano),
+		TotalVotes:       r.totalVotes.Text(10),
+		TotalVotedStakes: r.totalVotedStakes.Text(10),
+		Pruned:           r.pruned,
+		Delegates:        make([]*delegateJSON, len(r.delegates)),
+	}
+	for i, d := range r.delegates {
+		votes := r.VotesByDelegate(d.name)
+		rJSON.Delegates[i] = &delegateJSON{
+			candidateJSON: *d.toJSON(),
+			Votes:         make([]*voteJSON, len(votes)),
+		}
+		for j, v := range votes {
+			rJSON.Delegates[i].Votes[j] = v.toJSON()
+		}
+	}
+
+	return json.Marshal(rJSON)
+}
+
+// UnmarshalJSON decodes the result from JSON
+func (r *ElectionResult) UnmarshalJSON(data []byte) (err error) {
+	rJSON := &resultJSON{}
+	if err = json.Unmarshal(data, rJSON); err != nil {
+		return err
+	}
+	if r.mintTime, err = time.Parse(time.RFC3339, rJSON.MintTime); err != nil {
+		return errors.Wrap(err, "invalid mint time")
+	}
+	if r.totalVotes, err = parseAmount(rJSON.TotalVotes); err != nil {
+		return errors.Wrap(err, "invalid total votes")
+	}
+	if r.totalVotedStakes, err = parseAmount(rJSON.TotalVotedStakes); err != nil {
+		return errors.Wrap(err, "invalid total voted stakes")
+	}
+	r.pruned = rJSON.Pruned
+	r.delegates = make([]*Candidate, len(rJSON.Delegates))
+	r.votes = map[string][]*Vote{}
+	for i, dJSON := range rJSON.Delegates {
+		r.delegates[i] = &Candidate{}
+		if err = r.delegates[i].fromJSON(&dJSON.candidateJSON); err != nil {
+			return err
+		}
+		name := hex.EncodeToString(r.delegates[i].name)
+		if _, ok := r.votes[name]; ok {
+			return errors.Errorf("duplicate delegate %s", name)
+		}
+		r.votes[name] = make([]*Vote, len(dJSON.Votes))
+		for j, vJSON := range dJSON.Votes {
+			r.votes[name][j] = &Vote{}
+			if err = r.votes[name][j].fromJSON(vJSON); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// readableName returns the name as text if it is printable, or as hex string otherwise
+func readableName(name []byte) string {
+	trimmed := bytes.TrimRight(name, "\x00")
+	if !utf8.Valid(trimmed) {
+		return hex.EncodeToString(name)
+	}
+	for _, r := range string(trimmed) {
+		if !unicode.IsPrint(r) {
+			return hex.EncodeToString(name)
+		}
+	}
+	return string(trimmed)
+}
+
+func parseAmount(s string) (*big.Int, error) {
+	amount, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, errors.Errorf("%s is not a decimal number", s)
+	}
+	return amount, nil
+}
+
+// FormatISODuration formats a duration in ISO 8601, with days as the largest unit
+func FormatISODuration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+	var builder strings.Builder
+	if d < 0 {
+		builder.WriteString("-")
+		d = -d
+	}
+	builder.WriteString("P")
+	day := 24 * time.Hour
+	if days := d / day; days > 0 {
+		builder.WriteString(strconv.FormatInt(int64(days), 10) + "D")
+		d -= days * day
+	}
+	if d > 0 {
+		builder.WriteString("T")
+		if hours := d / time.Hour; hours > 0 {
+			builder.WriteString(strconv.FormatInt(int64(hours), 10) + "H")
+			d -= hours * time.Hour
+		}
+		if minutes := d / time.Minute; minutes > 0 {
+			builder.WriteString(strconv.FormatInt(int64(minutes), 10) + "M")
+			d -= minutes * time.Minute
+		}
+		if d > 0 {
+			builder.WriteString(strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "S")
+		}
+	}
+	return builder.String()
+}
+
+// ParseISODuration parses a duration in ISO 8601 with days, hours, minutes and seconds, e.g.,
+// P14DT12H30M1.5S
+func ParseISODuration(s string) (time.Duration, error) {
+	invalid := errors.Errorf("invalid ISO 8601 duration %s", s)
+	negative := strings.HasPrefix(s, "-")
+	rest := strings.TrimPrefix(s, "-")
+	if !strings.HasPrefix(rest, "P") || len(rest) == 1 {
+		return 0, invalid
+	}
+	rest = rest[1:]
+	var d time.Duration
+	units := map[byte]time.Duration{'D': 24 * time.Hour}
+	inTime := false
+	for len(rest) > 0 {
+		if rest[0] == 'T' {
+			if inTime || len(rest) == 1 {
+				return 0, invalid
+			}
+			inTime = true
+			units = map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}
+			rest = rest[1:]
+			continue
+		}
+		i := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		if i <= 0 {
+			return 0, invalid
+		}
+		unit, ok := units[rest[i]]
+		if !ok {
+			return 0, invalid
+		}
+		value, err := strconv.ParseFloat(rest[:i], 64)
+		if err != nil {
+			return 0, invalid
+		}
+		d += time.Duration(value * float64(unit))
+		// each unit appears once and in order
+		for u := range units {
+			if units[u] >= unit {
+				delete(units, u)
+			}
+		}
+		rest = rest[i+1:]
+	}
+	if negative {
+		d = -d
+	}
+	return d, nil
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestISODuration(t *testing.T) {
+	require := require.New(t)
+	for s, d := range map[string]time.Duration{
+		"PT0S":            0,
+		"P14D":            14 * 24 * time.Hour,
+		"P1DT2H":          26 * time.Hour,
+		"PT3M":            3 * time.Minute,
+		"P1DT1H1M1.5S":    25*time.Hour + time.Minute + 1500*time.Millisecond,
+		"-PT1H":           -time.Hour,
+		"P21DT12H30M0.1S": 21*24*time.Hour + 12*time.Hour + 30*time.Minute + 100*time.Millisecond,
+	} {
+		require.Equal(s, FormatISODuration(d))
+		parsed, err := ParseISODuration(s)
+		require.NoError(err)
+		require.Equal(d, parsed)
+	}
+	for _, s := range []string{"", "P", "PT", "14D", "P1H", "PT1D", "PT1M1H", "P1DT", "P1D1D", "PTxS"} {
+		_, err := ParseISODuration(s)
+		require.Error(err, s)
+	}
+}
+
+func TestReadableName(t *testing.T) {
+	require := require.New(t)
+	require.Equal("robotbp", readableName([]byte("robotbp\x00\x00\x00\x00\x00")))
+	require.Equal("robotbp00000", readableName([]byte("robotbp00000")))
+	require.Equal("0001ff", readableName([]byte{0, 1, 255}))
+}
+
+func TestJSONEncoding(t *testing.T) {
+	require := require.New(t)
+	addr, err := hex.DecodeString("00112233445566778899aabbccddeeff00112233")
+	require.NoError(err)
+	candidate := NewCandidate([]byte("robotbp00000"), addr, []byte("io1operator"), []byte("io1reward"), 2)
+	candidate.SetScore(big.NewInt(1234))
+	candidate.SetSelfStakingTokens(big.NewInt(56))
+	data, err := json.Marshal(candidate)
+	require.NoError(err)
+	fields := map[string]interface{}{}
+	require.NoError(json.Unmarshal(data, &fields))
+	require.Equal("robotbp00000", fields["name"])
+	require.Equal("0x00112233445566778899aabbccddeeff00112233", fields["address"])
+	require.True(strings.HasPrefix(fields["ioAddress"].(string), "io1"))
+	require.Equal("1234", fields["score"])
+	decoded := &Candidate{}
+	require.NoError(json.Unmarshal(data, decoded))
+	require.True(candidate.equal(decoded))
+
+	mintTime := time.Now().Add(-10 * time.Hour)
+	calculator := NewResultCalculator(
+		mintTime,
+		false,
+		mockVoteFilter(10),
+		mockCalcWeight,
+		mockCandidateFilter(0, 0),
+	)
+	require.NoError(calculator.AddCandidates(genTestCandidates()))
+	require.NoError(calculator.AddVotes(genTestVotes(mintTime, require)))
+	result, err := calculator.Calculate()
+	require.NoError(err)
+	data, err = json.Marshal(result)
+	require.NoError(err)
+	decodedResult := &ElectionResult{}
+	require.NoError(json.Unmarshal(data, decodedResult))
+	expected, err := result.Serialize()
+	require.NoError(err)
+	actual, err := decodedResult.Serialize()
+	require.NoError(err)
+	require.Equal(expected, actual)
+	// the encoding is stable
+	again, err := json.Marshal(decodedResult)
+	require.NoError(err)
+	require.Equal(data, again)
+
+	require.Error(json.Unmarshal([]byte(`{"nameHex":"xyz"}`), &Candidate{}))
+	require.Error(json.Unmarshal([]byte(`{"amount":"1.5"}`), &Vote{}))
+}
+
+func TestCSVEncoding(t *testing.T) {
+	require := require.New(t)
+	mintTime := time.Now()
+	votes := genTestVotes(mintTime, require)
+	var buf bytes.Buffer
+	require.NoError(WriteVotesCSV(&buf, votes))
+	require.True(strings.HasPrefix(buf.String(), strings.Join(VoteCSVHeader, ",")+"\n"))
+	decodedVotes, err := ReadVotesCSV(&buf)
+	require.NoError(err)
+	require.Equal(len(votes), len(decodedVotes))
+	for i, v := range votes {
+		require.True(v.equal(decodedVotes[i]))
+	}
+
+	candidates := genTestCandidates()
+	buf.Reset()
+	require.NoError(WriteCandidatesCSV(&buf, candidates))
+	decodedCandidates, err := ReadCandidatesCSV(&buf)
+	require.NoError(err)
+	require.Equal(len(candidates), len(decodedCandidates))
+	for i, c := range candidates {
+		require.True(c.equal(decodedCandidates[i]))
+	}
+
+	_, err = ReadVotesCSV(strings.NewReader(strings.Join(CandidateCSVHeader, ",")))
+	require.Error(err)
+	_, err = ReadCandidatesCSV(strings.NewReader(""))
+	require.Error(err)
+}
@@ -0,0 +1,279 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+
+	pb "github.com/iotexproject/iotex-election/pb/election"
+)
+
+// The merkle tree of an election result is built as follows:
+//   - a leaf is the blake2b hash of 0x00 || serialized candidate or vote
+//   - an inner node is the blake2b hash of 0x01 || left child || right child, and the last node of
+//     an odd level is promoted to the upper level as is
+//   - the delegates tree is built on the delegates in rank order, and the votes tree is built on
+//     the votes of delegates in rank order, each of which in the stored order
+//   - the root is the inner node of the roots of delegates tree and votes tree
+const (
+	leafPrefix byte = 0
+	nodePrefix byte = 1
+)
+
+// ErrInvalidCommitment indicates that the result does not match the commitment
+var ErrInvalidCommitment = errors.New("result does not match the commitment")
+
+// ProofStep defines a step of a merkle proof, which is the hash of the sibling node
+type ProofStep struct {
+	Hash hash.Hash256
+	// Left is true if the sibling is the left child
+	Left bool
+}
+
+// MerkleProof defines an inclusion proof of a candidate or a vote in an election result
+type MerkleProof struct {
+	// Leaf is the serialized candidate or vote
+	Leaf  []byte
+	Steps []ProofStep
+}
+
+// Verify returns true if the proof matches the root
+func (p *MerkleProof) Verify(root hash.Hash256) bool {
+	h := leafHash(p.Leaf)
+	for _, step := range p.Steps {
+		if step.Left {
+			h = nodeHash(step.Hash, h)
+		} else {
+			h = nodeHash(h, step.Hash)
+		}
+	}
+	return h == root
+}
+
+// ResultCommitment defines the merkle roots of an election result
+type ResultCommitment struct {
+	Root          hash.Hash256
+	DelegatesRoot hash.Hash256
+	VotesRoot     hash.Hash256
+}
+
+// ToProtoMsg converts the commitment to protobuf
+func (c *ResultCommitment) ToProtoMsg() *pb.ResultCommitment {
+	return &pb.ResultCommitment{
+		Root:          c.Root[:],
+		DelegatesRoot: c.DelegatesRoot[:],
+		VotesRoot:     c.VotesRoot[:],
+	}
+}
+
+// Serialize converts the commitment to byte array
+func (c *ResultCommitment) Serialize() ([]byte, error) {
+	return proto.Marshal(c.ToProtoMsg())
+}
+
+// FromProtoMsg extracts commitment details from protobuf message
+func (c *ResultCommitment) FromProtoMsg(cPb *pb.ResultCommitment) error {
+	if len(cPb.Root) != len(c.Root) ||
+		len(cPb.DelegatesRoot) != len(c.DelegatesRoot) ||
+		len(cPb.VotesRoot) != len(c.VotesRoot) {
+		return errors.Wrap(ErrInvalidProto, "invalid length of merkle root")
+	}
+	copy(c.Root[:], cPb.Root)
+	copy(c.DelegatesRoot[:], cPb.DelegatesRoot)
+	copy(c.VotesRoot[:], cPb.VotesRoot)
+
+	return nil
+}
+
+// Deserialize converts a byte array to commitment
+func (c *ResultCommitment) Deserialize(data []byte) error {
+	cPb := &pb.ResultCommitment{}
+	if err := proto.Unmarshal(data, cPb); err != nil {
+		return err
+	}
+
+	return c.FromProtoMsg(cPb)
+}
+
+// Commitment calculates the merkle roots of the result
+func (r *ElectionResult) Commitment() (*ResultCommitment, error) {
+	if r.pruned {
+		return nil, errors.New("cannot calculate commitment of a pruned result")
+	}
+	delegateLeaves, err := r.delegateLeaves()
+	if err != nil {
+		return nil, err
+	}
+	voteLeaves, err := r.voteLeaves()
+	if err != nil {
+		return nil, err
+	}
+	c := &ResultCommitment{
+		DelegatesRoot: merkleRoot(delegateLeaves),
+		VotesRoot:     merkleRoot(voteLeaves),
+	}
+	c.Root = nodeHash(c.DelegatesRoot, c.VotesRoot)
+
+	return c, nil
+}
+
+// CandidateProof returns the inclusion proof of a delegate against the commitment. It works for a
+// pruned result as well, since the votes root is taken from the commitment.
+func (r *ElectionResult) CandidateProof(name []byte, c *ResultCommitment) (*MerkleProof, error) {
+	index := -1
+	for i, d := range r.delegates {
+		if bytes.Equal(d.name, name) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, errors.Errorf("cannot find delegate %x", name)
+	}
+	leaves, err := r.delegateLeaves()
+	if err != nil {
+		return nil, err
+	}
+	if merkleRoot(leaves) != c.DelegatesRoot {
+		return nil, ErrInvalidCommitment
+	}
+	leaf, err := r.delegates[index].Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	return &MerkleProof{
+		Leaf:  leaf,
+		Steps: append(merklePath(leaves, index), ProofStep{Hash: c.VotesRoot}),
+	}, nil
+}
+
+// VoteProof returns the inclusion proof of the index-th vote of a delegate against the commitment
+func (r *ElectionResult) VoteProof(name []byte, index int, c *ResultCommitment) (*MerkleProof, error) {
+	if r.pruned {
+		return nil, errors.New("cannot generate vote proof of a pruned result")
+	}
+	offset := 0
+	var leaf []byte
+	var err error
+	for _, d := range r.delegates {
+		votes := r.votes[hex.EncodeToString(d.name)]
+		if !bytes.Equal(d.name, name) {
+			offset += len(votes)
+			continue
+		}
+		if index < 0 || index >= len(votes) {
+			return nil, errors.Errorf("vote index %d is out of range", index)
+		}
+		if leaf, err = votes[index].Serialize(); err != nil {
+			return nil, err
+		}
+		break
+	}
+	if leaf == nil {
+		return nil, errors.Errorf("cannot find delegate %x", name)
+	}
+	leaves, err := r.voteLeaves()
+	if err != nil {
+		return nil, err
+	}
+	if merkleRoot(leaves) != c.VotesRoot {
+		return nil, ErrInvalidCommitment
+	}
+
+	return &MerkleProof{
+		Leaf:  leaf,
+		Steps: append(merklePath(leaves, offset+index), ProofStep{Hash: c.DelegatesRoot, Left: true}),
+	}, nil
+}
+
+func (r *ElectionResult) delegateLeaves() ([]hash.Hash256, error) {
+	leaves := make([]hash.Hash256, len(r.delegates))
+	for i, d := range r.delegates {
+		data, err := d.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		leaves[i] = leafHash(data)
+	}
+	return leaves, nil
+}
+
+func (r *ElectionResult) voteLeaves() ([]hash.Hash256, error) {
+	leaves := []hash.Hash256{}
+	for _, d := range r.delegates {
+		for _, v := range r.votes[hex.EncodeToString(d.name)] {
+			data, err := v.Serialize()
+			if err != nil {
+				return nil, err
+			}
+			leaves = append(leaves, leafHash(data))
+		}
+	}
+	return leaves, nil
+}
+
+func leafHash(data []byte) hash.Hash256 {
+	return blake2b.Sum256(append([]byte{leafPrefix}, data...))
+}
+
+func nodeHash(left hash.Hash256, right hash.Hash256) hash.Hash256 {
+	data := make([]byte, 0, 1+len(left)+len(right))
+	data = append(data, nodePrefix)
+	data = append(data, left[:]...)
+
+	return blake2b.Sum256(append(data, right[:]...))
+}
+
+func merkleRoot(leaves []hash.Hash256) hash.Hash256 {
+	if len(leaves) == 0 {
+		return blake2b.Sum256(nil)
+	}
+	level := leaves
+	for len(level) > 1 {
+		level = nextLevel(level)
+	}
+	return level[0]
+}
+
+func merklePath(leaves []hash.Hash256, index int) []ProofStep {
+	steps := []ProofStep{}
+	level := leaves
+	for len(level) > 1 {
+		switch {
+		case index%2 == 1:
+			steps = append(steps, ProofStep{Hash: level[index-1], Left: true})
+		case index+1 < len(level):
+			steps = append(steps, ProofStep{Hash: level[index+1]})
+		}
+		level = nextLevel(level)
+		index /= 2
+	}
+	return steps
+}
+
+func nextLevel(level []hash.Hash256) []hash.Hash256 {
+	next := make([]hash.Hash256, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+		} else {
+			next = append(next, nodeHash(level[i], level[i+1]))
+		}
+	}
+	return next
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultCommitment(t *testing.T) {
+	require := require.New(t)
+	mintTime := time.Now()
+	calculator := NewResultCalculator(
+		mintTime,
+		false,
+		mockVoteFilter(10),
+		mockCalcWeight,
+		mockCandidateFilter(0, 0),
+	)
+	require.NoError(calculator.AddCandidates(genTestCandidates()))
+	require.NoError(calculator.AddVotes(genTestVotes(mintTime, require)))
+	result, err := calculator.Calculate()
+	require.NoError(err)
+	commitment, err := result.Commitment()
+	require.NoError(err)
+
+	t.Run("serialize", func(t *testing.T) {
+		data, err := commitment.Serialize()
+		require.NoError(err)
+		clone := &ResultCommitment{}
+		require.NoError(clone.Deserialize(data))
+		require.Equal(*commitment, *clone)
+		again, err := result.Commitment()
+		require.NoError(err)
+		require.Equal(*commitment, *again)
+	})
+	t.Run("candidate-proof", func(t *testing.T) {
+		for _, d := range result.Delegates() {
+			proof, err := result.CandidateProof(d.Name(), commitment)
+			require.NoError(err)
+			require.True(proof.Verify(commitment.Root))
+			c := &Candidate{}
+			require.NoError(c.Deserialize(proof.Leaf))
+			require.True(c.equal(d))
+		}
+		_, err := result.CandidateProof([]byte("unknown"), commitment)
+		require.Error(err)
+	})
+	t.Run("vote-proof", func(t *testing.T) {
+		for _, d := range result.Delegates() {
+			for i := range result.VotesByDelegate(d.Name()) {
+				proof, err := result.VoteProof(d.Name(), i, commitment)
+				require.NoError(err)
+				require.True(proof.Verify(commitment.Root))
+			}
+		}
+		_, err := result.VoteProof(result.Delegates()[0].Name(), 100, commitment)
+		require.Error(err)
+	})
+	t.Run("tampered", func(t *testing.T) {
+		proof, err := result.CandidateProof(result.Delegates()[0].Name(), commitment)
+		require.NoError(err)
+		c := &Candidate{}
+		require.NoError(c.Deserialize(proof.Leaf))
+		c.SetScore(new(big.Int).Add(c.Score(), big.NewInt(1)))
+		proof.Leaf, err = c.Serialize()
+		require.NoError(err)
+		require.False(proof.Verify(commitment.Root))
+	})
+	t.Run("pruned", func(t *testing.T) {
+		summary := result.Summary()
+		_, err := summary.Commitment()
+		require.Error(err)
+		proof, err := summary.CandidateProof(result.Delegates()[1].Name(), commitment)
+		require.NoError(err)
+		require.True(proof.Verify(commitment.Root))
+		_, err = summary.VoteProof(result.Delegates()[1].Name(), 0, commitment)
+		require.Error(err)
+	})
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/big"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	pb "github.com/iotexproject/iotex-election/pb/election"
+	"github.com/iotexproject/iotex-election/util"
+)
+
+// maxIntensityRate is the intensity rate excluding the delegates on probation
+const maxIntensityRate = uint32(100)
+
+// ProbationList defines the delegates on probation in an epoch, whose scores are reduced by the
+// intensity rate in percentage, or who are excluded if the rate is 100
+type ProbationList struct {
+	Epoch         uint64
+	IntensityRate uint32
+	Delegates     [][]byte
+}
+
+// Adjustment records the change of a delegate from the raw ranking to the adjusted one. Ranks start
+// from 1, and the rank of an excluded delegate is 0
+type Adjustment struct {
+	Name     []byte
+	RawRank  uint32
+	RawScore *big.Int
+	Rank     uint32
+	Score    *big.Int
+	Excluded bool
+}
+
+// Validate checks the intensity rate and the delegate names of the list
+func (l *ProbationList) Validate() error {
+	if l.IntensityRate > maxIntensityRate {
+		return errors.Errorf("intensity rate %d is larger than %d", l.IntensityRate, maxIntensityRate)
+	}
+	names := map[string]bool{}
+	for _, name := range l.Delegates {
+		key := hex.EncodeToString(name)
+		if names[key] {
+			return errors.Errorf("duplicate delegate %s on probation", key)
+		}
+		names[key] = true
+	}
+	return nil
+}
+
+// ToProtoMsg converts the list to a protobuf message
+func (l *ProbationList) ToProtoMsg() *pb.ProbationList {
+	delegates := make([][]byte, len(l.Delegates))
+	for i, name := range l.Delegates {
+		delegates[i] = util.CopyBytes(name)
+	}
+	return &pb.ProbationList{
+		Epoch:         l.Epoch,
+		IntensityRate: l.IntensityRate,
+		Delegates:     delegates,
+	}
+}
+
+// FromProtoMsg fills the list with a protobuf message
+func (l *ProbationList) FromProtoMsg(lPb *pb.ProbationList) error {
+	l.Epoch = lPb.Epoch
+	l.IntensityRate = lPb.IntensityRate
+	l.Delegates = make([][]byte, len(lPb.Delegates))
+	for i, name := range lPb.Delegates {
+		l.Delegates[i] = util.CopyBytes(name)
+	}
+	return l.Validate()
+}
+
+// Serialize serializes the list to bytes
+func (l *ProbationList) Serialize() ([]byte, error) {
+	return proto.Marshal(l.ToProtoMsg())
+}
+
+// Deserialize deserializes bytes to the list
+func (l *ProbationList) Deserialize(data []byte) error {
+	lPb := &pb.ProbationList{}
+	if err := proto.Unmarshal(data, lPb); err != nil {
+		return err
+	}
+	return l.FromProtoMsg(lPb)
+}
+
+type probationListJSON struct {
+	Epoch         uint64   `json:"epoch"`
+	IntensityRate uint32   `json:"intensityRate"`
+	Delegates     []string `json:"delegates"`
+}
+
+// ReadProbationLists reads probation lists from a JSON array, in which the delegates are listed in hex
+// names, e.g., [{"epoch": 1, "intensityRate": 90, "delegates": ["726f626f7462703030303030"]}]
+func ReadProbationLists(r io.Reader) ([]*ProbationList, error) {
+	lJSONs := []*probationListJSON{}
+	if err := json.NewDecoder(r).Decode(&lJSONs); err != nil {
+		return nil, errors.Wrap(err, "failed to decode probation lists")
+	}
+	lists := make([]*ProbationList, len(lJSONs))
+	for i, lJSON := range lJSONs {
+		lists[i] = &ProbationList{
+			Epoch:         lJSON.Epoch,
+			IntensityRate: lJSON.IntensityRate,
+			Delegates:     make([][]byte, len(lJSON.Delegates)),
+		}
+		for j, name := range lJSON.Delegates {
+			var err error
+			if lists[i].Delegates[j], err = hex.DecodeString(name); err != nil {
+				return nil, errors.Wrapf(err, "invalid delegate name in probation list of epoch %d", lJSON.Epoch)
+			}
+		}
+		if err := lists[i].Validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid probation list of epoch %d", lJSON.Epoch)
+		}
+	}
+	return lists, nil
+}
+
+// Adjustments returns the adjustments of an adjusted result in the raw ranking order, or nil for a raw
+// result
+func (r *ElectionResult) Adjustments() []*Adjustment {
+	return r.adjustments
+}
+
+// Adjust returns a copy of the result, in which the scores of the delegates on probation are reduced,
+// and the delegates are ranked again by the adjusted scores. The delegates excluded by the list are
+// removed along with their votes, while the totals remain those of the raw result. The adjustments are
+// recorded in the returned result
+func (r *ElectionResult) Adjust(list *ProbationList) (*ElectionResult, error) {
+	if r.adjustments != nil {
+		return nil, errors.New("Cannot adjust an adjusted result")
+	}
+	if err := list.Validate(); err != nil {
+		return nil, err
+	}
+	probation := map[string]bool{}
+	for _, name := range list.Delegates {
+		probation[hex.EncodeToString(name)] = true
+	}
+	adjusted := &ElectionResult{
+		mintTime:         r.mintTime,
+		votes:            map[string][]*Vote{},
+		totalVotes:       r.totalVotes,
+		totalVotedStakes: r.totalVotedStakes,
+		pruned:           r.pruned,
+		adjustments:      []*Adjustment{},
+	}
+	candidates := map[string]*Candidate{}
+	p := itemList{}
+	for i, d := range r.delegates {
+		name := hex.EncodeToString(d.name)
+		if !probation[name] {
+			candidates[name] = d
+		} else {
+			adjustment := &Adjustment{
+				Name:     d.Name(),
+				RawRank:  uint32(i + 1),
+				RawScore: d.Score(),
+				Score:    big.NewInt(0),
+				Excluded: list.IntensityRate == maxIntensityRate,
+			}
+			adjusted.adjustments = append(adjusted.adjustments, adjustment)
+			if adjustment.Excluded {
+				continue
+			}
+			adjustment.Score.Mul(d.score, big.NewInt(int64(maxIntensityRate-list.IntensityRate)))
+			adjustment.Score.Quo(adjustment.Score, big.NewInt(int64(maxIntensityRate)))
+			c := d.Clone()
+			c.SetScore(new(big.Int).Set(adjustment.Score))
+			candidates[name] = c
+		}
+		adjusted.votes[name] = r.votes[name]
+		// the same priority as the raw ranking for the ties of scores
+		p = append(p, newItem(name, candidates[name].score, r.mintTime))
+	}
+	sort.Stable(p)
+	adjusted.delegates = make([]*Candidate, len(p))
+	ranks := map[string]uint32{}
+	for i, it := range p {
+		adjusted.delegates[i] = candidates[it.Key]
+		ranks[it.Key] = uint32(i + 1)
+	}
+	for _, adjustment := range adjusted.adjustments {
+		adjustment.Rank = ranks[hex.EncodeToString(adjustment.Name)]
+	}
+
+	return adjusted, nil
+}
+
+func (a *Adjustment) toProtoMsg() *pb.Adjustment {
+	return &pb.Adjustment{
+		Name:     util.CopyBytes(a.Name),
+		RawRank:  a.RawRank,
+		RawScore: a.RawScore.Bytes(),
+		Rank:     a.Rank,
+		Score:    a.Score.Bytes(),
+		Excluded: a.Excluded,
+	}
+}
+
+func (a *Adjustment) fromProtoMsg(aPb *pb.Adjustment) {
+	a.Name = util.CopyBytes(aPb.Name)
+	a.RawRank = aPb.RawRank
+	a.RawScore = new(big.Int).SetBytes(aPb.RawScore)
+	a.Rank = aPb.Rank
+	a.Score = new(big.Int).SetBytes(aPb.Score)
+	a.Excluded = aPb.Excluded
+}
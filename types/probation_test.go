@@ -0,0 +1,113 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; 
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbation(t *testing.T) {
+	require := require.New(t)
+	now := time.Now()
+	calculator := NewResultCalculator(
+		now,
+		false,
+		func(*Vote) bool { return false },
+		func(v *Vote, _ time.Time) *big.Int { return v.Amount() },
+		func(*Candidate) bool { return false },
+	)
+	names := [][]byte{[]byte("alice0000000"), []byte("bob000000000"), []byte("carol0000000")}
+	candidates := []*Candidate{}
+	votes := []*Vote{}
+	for i, name := range names {
+		candidates = append(candidates, NewCandidate(name, []byte{byte(i)}, nil, nil, 1))
+		v, err := NewVote(now, 0, big.NewInt(int64(300-i*50)), big.NewInt(0), []byte{byte(10 + i)}, name, false)
+		require.NoError(err)
+		votes = append(votes, v)
+	}
+	require.NoError(calculator.AddCandidates(candidates))
+	require.NoError(calculator.AddVotes(votes))
+	result, err := calculator.Calculate()
+	require.NoError(err)
+	require.Nil(result.Adjustments())
+
+	t.Run("reduce", func(t *testing.T) {
+		adjusted, err := result.Adjust(&ProbationList{Epoch: 1, IntensityRate: 50, Delegates: [][]byte{names[0]}})
+		require.NoError(err)
+		// alice of 300 is reduced to 150, behind bob of 250 and carol of 200
+		require.Equal(names[1], adjusted.Delegates()[0].Name())
+		require.Equal(names[2], adjusted.Delegates()[1].Name())
+		require.Equal(names[0], adjusted.Delegates()[2].Name())
+		require.Equal(int64(150), adjusted.Delegates()[2].Score().Int64())
+		require.Equal(int64(300), result.Delegates()[0].Score().Int64())
+		require.Equal(1, len(adjusted.Adjustments()))
+		a := adjusted.Adjustments()[0]
+		require.Equal(uint32(1), a.RawRank)
+		require.Equal(uint32(3), a.Rank)
+		require.Equal(int64(300), a.RawScore.Int64())
+		require.Equal(int64(150), a.Score.Int64())
+		require.False(a.Excluded)
+		require.Equal(1, len(adjusted.VotesByDelegate(names[0])))
+
+		// the adjustments are kept in proto
+		data, err := adjusted.Serialize()
+		require.NoError(err)
+		clone := &ElectionResult{}
+		require.NoError(clone.Deserialize(data))
+		require.Equal(adjusted.Adjustments(), clone.Adjustments())
+		_, err = adjusted.Adjust(&ProbationList{})
+		require.Error(err)
+	})
+
+	t.Run("exclude", func(t *testing.T) {
+		adjusted, err := result.Adjust(&ProbationList{Epoch: 1, IntensityRate: 100, Delegates: [][]byte{names[1]}})
+		require.NoError(err)
+		require.Equal(2, len(adjusted.Delegates()))
+		require.Nil(adjusted.DelegateByName(names[1]))
+		require.Equal(0, len(adjusted.VotesByDelegate(names[1])))
+		require.Equal(2, len(adjusted.Votes()))
+		a := adjusted.Adjustments()[0]
+		require.Equal(uint32(2), a.RawRank)
+		require.Equal(uint32(0), a.Rank)
+		require.True(a.Excluded)
+		_, err = adjusted.Serialize()
+		require.NoError(err)
+	})
+
+	t.Run("lists", func(t *testing.T) {
+		lists, err := ReadProbationLists(strings.NewReader(`[
+			{"epoch": 1, "intensityRate": 90, "delegates": ["` + hex.EncodeToString(names[0]) + `"]},
+			{"epoch": 2, "delegates": []}
+		]`))
+		require.NoError(err)
+		require.Equal(2, len(lists))
+		require.Equal(uint32(90), lists[0].IntensityRate)
+		require.Equal([][]byte{names[0]}, lists[0].Delegates)
+		data, err := lists[0].Serialize()
+		require.NoError(err)
+		list := &ProbationList{}
+		require.NoError(list.Deserialize(data))
+		require.Equal(lists[0], list)
+
+		_, err = ReadProbationLists(strings.NewReader(`[{"epoch": 1, "intensityRate": 101}]`))
+		require.Error(err)
+		_, err = ReadProbationLists(strings.NewReader(`[{"epoch": 1, "delegates": ["zz"]}]`))
+		require.Error(err)
+		_, err = result.Adjust(&ProbationList{IntensityRate: 10, Delegates: [][]byte{names[0], names[0]}})
+		require.Error(err)
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -28,6 +29,23 @@ import (
 // ErrInvalidProto indicates a format error of an election proto
 var ErrInvalidProto = errors.New("Invalid election proto")
 
+const (
+	// resultOverhead, candidateOverhead and voteOverhead are the approximate sizes of the structs
+	// themselves, excluding the memory the fields point to
+	resultOverhead    = 96
+	candidateOverhead = 136
+	voteOverhead      = 112
+	mapEntryOverhead  = 48
+	bigIntOverhead    = 32
+)
+
+func bigIntSize(i *big.Int) uint64 {
+	if i == nil {
+		return 0
+	}
+	return bigIntOverhead + uint64(len(i.Bits()))*8
+}
+
 // ElectionResult defines the collection of voting result on a height
 type ElectionResult struct {
 	mintTime         time.Time
@@ -35,6 +53,12 @@ type ElectionResult struct {
 	votes            map[string][]*Vote
 	totalVotes       *big.Int
 	totalVotedStakes *big.Int
+	pruned           bool
+	adjustments      []*Adjustment
+
+	// voters indexes the votes by voter, which is built on the first query
+	voters     map[string][]*Vote
+	votersOnce sync.Once
 }
 
 // MintTime returns the mint time of the corresponding gravity chain block
@@ -52,15 +76,38 @@ func (r *ElectionResult) VotesByDelegate(name []byte) []*Vote {
 	return r.votes[hex.EncodeToString(name)]
 }
 
-// Votes returns all votes
+// Votes returns all votes in the canonical order, i.e., grouped by delegates in the order of their
+// ranks, and in the order of the gravity chain buckets within a delegate
 func (r *ElectionResult) Votes() []*Vote {
 	votes := []*Vote{}
-	for _, vs := range r.votes {
-		votes = append(votes, vs...)
+	for _, delegate := range r.delegates {
+		votes = append(votes, r.votes[hex.EncodeToString(delegate.Name())]...)
 	}
 	return votes
 }
 
+// Pruned returns true if the votes of the result have been pruned
+func (r *ElectionResult) Pruned() bool {
+	return r.pruned
+}
+
+// Summary returns a copy of the result with delegates and totals only, with votes pruned
+func (r *ElectionResult) Summary() *ElectionResult {
+	votes := make(map[string][]*Vote, len(r.votes))
+	for name := range r.votes {
+		votes[name] = []*Vote{}
+	}
+	return &ElectionResult{
+		mintTime:         r.mintTime,
+		delegates:        r.delegates,
+		votes:            votes,
+		totalVotes:       r.totalVotes,
+		totalVotedStakes: r.totalVotedStakes,
+		pruned:           true,
+		adjustments:      r.adjustments,
+	}
+}
+
 // DelegateByName returns the candidate details
 func (r *ElectionResult) DelegateByName(name []byte) *Candidate {
 	for _, candidate := range r.delegates {
@@ -81,6 +128,22 @@ func (r *ElectionResult) TotalVotedStakes() *big.Int {
 	return new(big.Int).Set(r.totalVotedStakes)
 }
 
+// EstimatedSize returns an approximation of the memory occupied by the result in bytes
+func (r *ElectionResult) EstimatedSize() uint64 {
+	size := uint64(resultOverhead) + bigIntSize(r.totalVotes) + bigIntSize(r.totalVotedStakes)
+	for _, d := range r.delegates {
+		size += d.estimatedSize()
+	}
+	for name, votes := range r.votes {
+		size += mapEntryOverhead + uint64(len(name))
+		for _, v := range votes {
+			// the vote itself and its entry in the voter index
+			size += v.estimatedSize() + mapEntryOverhead + 2*uint64(len(v.voter)) + 8
+		}
+	}
+	return size
+}
+
 // ToProtoMsg converts the vote to protobuf
 func (r *ElectionResult) ToProtoMsg() (*pb.ElectionResult, error) {
 	delegates := make([]*pb.Candidate, len(r.delegates))
@@ -108,6 +171,10 @@ func (r *ElectionResult) ToProtoMsg() (*pb.ElectionResult, error) {
 	if err != nil {
 		return nil, err
 	}
+	var adjustments []*pb.Adjustment
+	for _, a := range r.adjustments {
+		adjustments = append(adjustments, a.toProtoMsg())
+	}
 
 	return &pb.ElectionResult{
 		Timestamp:        t,
@@ -115,6 +182,8 @@ func (r *ElectionResult) ToProtoMsg() (*pb.ElectionResult, error) {
 		DelegateVotes:    delegateVotes,
 		TotalVotedStakes: r.totalVotedStakes.Bytes(),
 		TotalVotes:       r.totalVotes.Bytes(),
+		Pruned:           r.pruned,
+		Adjustments:      adjustments,
 	}, nil
 }
 
@@ -166,6 +235,15 @@ func (r *ElectionResult) FromProtoMsg(rPb *pb.ElectionResult) (err error) {
 	}
 	r.totalVotedStakes = new(big.Int).SetBytes(rPb.TotalVotedStakes)
 	r.totalVotes = new(big.Int).SetBytes(rPb.TotalVotes)
+	r.pruned = rPb.Pruned
+	r.adjustments = nil
+	if len(rPb.Adjustments) > 0 {
+		r.adjustments = make([]*Adjustment, len(rPb.Adjustments))
+		for i, aPb := range rPb.Adjustments {
+			r.adjustments[i] = &Adjustment{}
+			r.adjustments[i].fromProtoMsg(aPb)
+		}
+	}
 
 	return nil
 }
@@ -211,20 +289,20 @@ func NewElectionResultForTest(
 	return &ElectionResult{
 		mintTime: mintTime,
 		delegates: []*Candidate{
-			&Candidate{
+			(&Candidate{
 				name:            []byte("name1"),
 				address:         []byte("address1"),
 				operatorAddress: []byte("io1kfpsvefk74cqxd245j2h5t2pld2wtxzyg6tqrt"),
 				rewardAddress:   []byte("io1kfpsvefk74cqxd245j2h5t2pld2wtxzyg6tqrt"),
 				score:           big.NewInt(15),
-			},
-			&Candidate{
+			}).parseAddresses(),
+			(&Candidate{
 				name:            []byte("name2"),
 				address:         []byte("address2"),
 				operatorAddress: []byte("io1llr6zs37gxrwmvnczexpg35dptta2mdvjv6w2q"),
 				rewardAddress:   []byte("io1llr6zs37gxrwmvnczexpg35dptta2mdvjv6w2q"),
 				score:           big.NewInt(14),
-			},
+			}).parseAddresses(),
 		},
 		votes: map[string][]*Vote{
 			"name1": []*Vote{},
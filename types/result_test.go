@@ -89,6 +89,16 @@ func TestElectionResult(t *testing.T) {
 				require.True(v.equal(cvs[j]))
 			}
 		}
+		summary := result.Summary()
+		require.True(summary.Pruned())
+		require.False(result.Pruned())
+		b, err = summary.Serialize()
+		require.NoError(err)
+		clone = &ElectionResult{}
+		require.NoError(clone.Deserialize(b))
+		require.True(clone.Pruned())
+		require.Equal(len(cs), len(clone.Delegates()))
+		require.Equal(0, len(clone.Votes()))
 	})
 }
 
@@ -31,6 +31,17 @@ type item struct {
 	Priority uint64
 }
 
+// newItem returns the item ranking a candidate by score, and by the priority derived from the name and
+// the mint time for the ties of scores
+func newItem(name string, score *big.Int, mintTime time.Time) item {
+	priority := blake2b.Sum256(append([]byte(name), util.Uint64ToBytes(uint64(mintTime.Unix()))...))
+	return item{
+		Key:      name,
+		Value:    score,
+		Priority: util.BytesToUint64(priority[:8]),
+	}
+}
+
 type itemList []item
 
 func (p itemList) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
@@ -60,10 +71,28 @@ type VoteFilterFunc func(*Vote) bool
 // CandidateFilterFunc defines the function to filter candidate
 type CandidateFilterFunc func(*Candidate) bool
 
+// CandidateExclusionFunc defines the function to filter candidate, which returns true along with the
+// reason if the candidate should be excluded
+type CandidateExclusionFunc func(*Candidate) (ExclusionReason, bool)
+
+// ThresholdFilter returns the candidate exclusion function of the score and self staking thresholds
+func ThresholdFilter(scoreThreshold *big.Int, selfStakingThreshold *big.Int) CandidateExclusionFunc {
+	return func(c *Candidate) (ExclusionReason, bool) {
+		switch {
+		case selfStakingThreshold.Cmp(c.SelfStakingTokens()) > 0:
+			return LowSelfStakingCandidate, true
+		case scoreThreshold.Cmp(c.Score()) > 0:
+			return LowScoreCandidate, true
+		default:
+			return 0, false
+		}
+	}
+}
+
 // ResultCalculator defines a calculator for a set of votes
 type ResultCalculator struct {
 	calcScore        func(*Vote, time.Time) *big.Int
-	candidateFilter  func(*Candidate) bool
+	candidateFilter  CandidateExclusionFunc
 	voteFilter       func(*Vote) bool
 	mintTime         time.Time
 	candidates       map[string]*Candidate
@@ -73,15 +102,35 @@ type ResultCalculator struct {
 	calculated       bool
 	mutex            sync.RWMutex
 	skipManified     bool
+	audit            *ExclusionAudit
 }
 
-// NewResultCalculator creates a result calculator
+// NewResultCalculator creates a result calculator, which records the candidates rejected by the
+// candidate filter as FilteredCandidate
 func NewResultCalculator(
 	mintTime time.Time,
 	skipManified bool,
 	voteFilter VoteFilterFunc, // filter votes before calculating
 	calcScore func(*Vote, time.Time) *big.Int,
 	candidateFilter CandidateFilterFunc, // filter candidates during calculating
+) *ResultCalculator {
+	var candidateExclusion CandidateExclusionFunc
+	if candidateFilter != nil {
+		candidateExclusion = func(c *Candidate) (ExclusionReason, bool) {
+			return FilteredCandidate, candidateFilter(c)
+		}
+	}
+	return NewResultCalculatorWithExclusion(mintTime, skipManified, voteFilter, calcScore, candidateExclusion)
+}
+
+// NewResultCalculatorWithExclusion creates a result calculator, which records the candidates excluded
+// during calculating with the reasons returned by the candidate exclusion function
+func NewResultCalculatorWithExclusion(
+	mintTime time.Time,
+	skipManified bool,
+	voteFilter VoteFilterFunc, // filter votes before calculating
+	calcScore func(*Vote, time.Time) *big.Int,
+	candidateFilter CandidateExclusionFunc, // exclude candidates during calculating
 ) *ResultCalculator {
 	return &ResultCalculator{
 		calcScore:        calcScore,
@@ -94,6 +143,7 @@ func NewResultCalculator(
 		totalVotes:       big.NewInt(0),
 		calculated:       false,
 		skipManified:     skipManified,
+		audit:            &ExclusionAudit{},
 	}
 }
 
@@ -112,7 +162,11 @@ func (calculator *ResultCalculator) AddCandidates(candidates []*Candidate) error
 		if _, exists := calculator.candidates[name]; exists {
 			return errors.Errorf("Duplicate candidate %s", name)
 		}
+		if c.MalformedAddresses() != nil {
+			calculator.audit.malformed = append(calculator.audit.malformed, c.Clone())
+		}
 		if c.SelfStakingWeight() > uint64(1) && calculator.skipManified {
+			calculator.excludeCandidate(c.Clone(), ManifiedCandidate)
 			continue
 		}
 		calculator.candidates[name] = c.Clone().reset()
@@ -130,14 +184,17 @@ func (calculator *ResultCalculator) AddVotes(votes []*Vote) error {
 	}
 	for _, v := range votes {
 		if calculator.voteFilter(v) {
+			calculator.excludeVote(v.Clone(), FilteredVote)
 			continue
 		}
 		name := v.Candidate()
 		if name == nil {
+			calculator.excludeVote(v.Clone(), ZeroCandidateVote)
 			continue
 		}
 		nameHex := calculator.hex(name)
 		if strings.Compare(nameHex, candidateZero) == 0 {
+			calculator.excludeVote(v.Clone(), ZeroCandidateVote)
 			continue
 		}
 		amount := v.Amount()
@@ -159,6 +216,8 @@ func (calculator *ResultCalculator) AddVotes(votes []*Vote) error {
 				return err
 			}
 			calculator.candidateVotes[nameHex] = append(calculator.candidateVotes[nameHex], cVote)
+		} else {
+			calculator.excludeVote(v.Clone(), UnknownCandidateVote)
 		}
 		calculator.totalVotedStakes.Add(calculator.totalVotedStakes, amount)
 		calculator.totalVotes.Add(calculator.totalVotes, score)
@@ -166,6 +225,83 @@ func (calculator *ResultCalculator) AddVotes(votes []*Vote) error {
 	return nil
 }
 
+// Shard creates a calculator with the same settings and candidates but no votes. Shards could be fed
+// with disjoint sets of votes concurrently, and then be merged back via Merge
+func (calculator *ResultCalculator) Shard() (*ResultCalculator, error) {
+	calculator.mutex.RLock()
+	defer calculator.mutex.RUnlock()
+	if calculator.calculated {
+		return nil, errors.New("Cannot shard a calculated result")
+	}
+	if calculator.totalVotes.Cmp(big.NewInt(0)) > 0 {
+		return nil, errors.New("Shards should be created before any votes")
+	}
+	shard := NewResultCalculatorWithExclusion(
+		calculator.mintTime,
+		calculator.skipManified,
+		calculator.voteFilter,
+		calculator.calcScore,
+		calculator.candidateFilter,
+	)
+	for name, c := range calculator.candidates {
+		shard.candidates[name] = c.Clone().reset()
+		shard.candidateVotes[name] = []*Vote{}
+	}
+	return shard, nil
+}
+
+// Merge merges the votes of the shards into the calculator. The shards are merged in the given order,
+// such that the result is the same as adding their votes one shard after another. A merged shard
+// cannot be modified anymore
+func (calculator *ResultCalculator) Merge(shards ...*ResultCalculator) error {
+	calculator.mutex.Lock()
+	defer calculator.mutex.Unlock()
+	if calculator.calculated {
+		return errors.New("Cannot modify a calculated result")
+	}
+	for i, shard := range shards {
+		if err := calculator.merge(shard); err != nil {
+			return errors.Wrapf(err, "failed to merge shard %d", i)
+		}
+	}
+	return nil
+}
+
+func (calculator *ResultCalculator) merge(shard *ResultCalculator) error {
+	if shard == calculator {
+		return errors.New("Cannot merge a calculator into itself")
+	}
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+	if shard.calculated {
+		return errors.New("Cannot merge a calculated or merged shard")
+	}
+	if len(shard.candidates) != len(calculator.candidates) {
+		return errors.New("Shard candidates mismatch")
+	}
+	for name := range shard.candidates {
+		if _, exists := calculator.candidates[name]; !exists {
+			return errors.Errorf("Unknown candidate %s in shard", name)
+		}
+	}
+	for name, c := range shard.candidates {
+		candidate := calculator.candidates[name]
+		if err := candidate.addScore(c.score); err != nil {
+			return err
+		}
+		if err := candidate.addSelfStakingTokens(c.selfStakingTokens); err != nil {
+			return err
+		}
+		calculator.candidateVotes[name] = append(calculator.candidateVotes[name], shard.candidateVotes[name]...)
+	}
+	calculator.totalVotes.Add(calculator.totalVotes, shard.totalVotes)
+	calculator.totalVotedStakes.Add(calculator.totalVotedStakes, shard.totalVotedStakes)
+	calculator.audit.votes = append(calculator.audit.votes, shard.audit.votes...)
+	shard.calculated = true
+
+	return nil
+}
+
 // Calculate summaries the result with candidates and votes added
 func (calculator *ResultCalculator) Calculate() (*ElectionResult, error) {
 	calculator.mutex.Lock()
@@ -173,7 +309,13 @@ func (calculator *ResultCalculator) Calculate() (*ElectionResult, error) {
 	if calculator.calculated {
 		return nil, errors.New("Cannot modify a calculated result")
 	}
-	qualifiers := calculator.filterAndSortCandidates()
+	qualifiers, disqualifiers, reasons := calculator.filterAndSortCandidates()
+	for _, name := range disqualifiers {
+		calculator.excludeCandidate(calculator.candidates[name], reasons[name])
+		for _, v := range calculator.candidateVotes[name] {
+			calculator.excludeVote(v, ExcludedCandidateVote)
+		}
+	}
 	candidates := make([]*Candidate, len(qualifiers))
 	votes := map[string][]*Vote{}
 	for i, name := range qualifiers {
@@ -191,18 +333,40 @@ func (calculator *ResultCalculator) Calculate() (*ElectionResult, error) {
 	}, nil
 }
 
-func (calculator *ResultCalculator) filterAndSortCandidates() []string {
+// Audit returns the candidates and votes excluded from the calculated result
+func (calculator *ResultCalculator) Audit() (*ExclusionAudit, error) {
+	calculator.mutex.RLock()
+	defer calculator.mutex.RUnlock()
+	if !calculator.calculated {
+		return nil, errors.New("Result has not been calculated")
+	}
+	return calculator.audit, nil
+}
+
+func (calculator *ResultCalculator) excludeCandidate(c *Candidate, reason ExclusionReason) {
+	calculator.audit.candidates = append(
+		calculator.audit.candidates,
+		ExcludedCandidate{Candidate: c, Reason: reason},
+	)
+}
+
+func (calculator *ResultCalculator) excludeVote(v *Vote, reason ExclusionReason) {
+	calculator.audit.votes = append(calculator.audit.votes, ExcludedVote{Vote: v, Reason: reason})
+}
+
+// filterAndSortCandidates returns the sorted qualified candidates, and the disqualified ones in
+// name order with the reasons
+func (calculator *ResultCalculator) filterAndSortCandidates() ([]string, []string, map[string]ExclusionReason) {
 	p := make(itemList, len(calculator.candidates))
 	num := 0
-	tsBytes := util.Uint64ToBytes(uint64(calculator.mintTime.Unix()))
+	disqualifiers := []string{}
+	reasons := map[string]ExclusionReason{}
 	for name, candidate := range calculator.candidates {
-		if !calculator.candidateFilter(candidate) {
-			priority := blake2b.Sum256(append([]byte(name), tsBytes...))
-			p[num] = item{
-				Key:      name,
-				Value:    candidate.score,
-				Priority: util.BytesToUint64(priority[:8]),
-			}
+		if reason, excluded := calculator.candidateFilter(candidate); excluded {
+			disqualifiers = append(disqualifiers, name)
+			reasons[name] = reason
+		} else {
+			p[num] = newItem(name, candidate.score, calculator.mintTime)
 			num++
 		}
 	}
@@ -211,7 +375,9 @@ func (calculator *ResultCalculator) filterAndSortCandidates() []string {
 	for i := 0; i < num; i++ {
 		qualifiers[i] = p[i].Key
 	}
-	return qualifiers
+	sort.Strings(disqualifiers)
+
+	return qualifiers, disqualifiers, reasons
 }
 
 func (calculator *ResultCalculator) hex(name []byte) string {
@@ -212,6 +212,79 @@ func TestResultCalculator(t *testing.T) {
 	})
 }
 
+func TestMergeShards(t *testing.T) {
+	require := require.New(t)
+	mintTime := time.Now().Add(-10 * time.Hour)
+	candidates := genTestCandidates()
+	votes := genTestVotes(mintTime, require)
+	newCalculator := func() *ResultCalculator {
+		calculator := NewResultCalculator(
+			mintTime,
+			false,
+			mockVoteFilter(10),
+			mockCalcWeight,
+			mockCandidateFilter(2000, 1000),
+		)
+		require.NoError(calculator.AddCandidates(candidates))
+		return calculator
+	}
+	serial := newCalculator()
+	require.NoError(serial.AddVotes(votes))
+	expected, err := serial.Calculate()
+	require.NoError(err)
+	expectedAudit, err := serial.Audit()
+	require.NoError(err)
+
+	calculator := newCalculator()
+	shards := make([]*ResultCalculator, 3)
+	for i := range shards {
+		shards[i], err = calculator.Shard()
+		require.NoError(err)
+	}
+	// feed the shards in reverse order to make sure the merge order is what matters
+	pageSize := (len(votes) + len(shards) - 1) / len(shards)
+	for i := len(shards) - 1; i >= 0; i-- {
+		end := (i + 1) * pageSize
+		if end > len(votes) {
+			end = len(votes)
+		}
+		require.NoError(shards[i].AddVotes(votes[i*pageSize : end]))
+	}
+	require.NoError(calculator.Merge(shards...))
+	require.Error(calculator.Merge(shards[0]))
+	require.Error(shards[0].AddVotes(votes))
+	_, err = calculator.Shard()
+	require.Error(err)
+	result, err := calculator.Calculate()
+	require.NoError(err)
+	audit, err := calculator.Audit()
+	require.NoError(err)
+
+	expectedBytes, err := expected.Serialize()
+	require.NoError(err)
+	resultBytes, err := result.Serialize()
+	require.NoError(err)
+	require.Equal(expectedBytes, resultBytes)
+	expectedBytes, err = expectedAudit.Serialize()
+	require.NoError(err)
+	auditBytes, err := audit.Serialize()
+	require.NoError(err)
+	require.Equal(expectedBytes, auditBytes)
+
+	t.Run("mismatched-candidates", func(t *testing.T) {
+		calculator := newCalculator()
+		shard := NewResultCalculator(
+			mintTime,
+			false,
+			mockVoteFilter(10),
+			mockCalcWeight,
+			mockCandidateFilter(2000, 1000),
+		)
+		require.Error(calculator.Merge(shard))
+		require.Error(calculator.Merge(calculator))
+	})
+}
+
 func mockCalcWeight(v *Vote, t time.Time) *big.Int {
 	if t.Before(v.StartTime()) {
 		return big.NewInt(0)
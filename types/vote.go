@@ -92,6 +92,13 @@ func (v *Vote) SetWeightedAmount(w *big.Int) error {
 	return nil
 }
 
+func (v *Vote) estimatedSize() uint64 {
+	return voteOverhead +
+		uint64(len(v.voter)+len(v.candidate)) +
+		bigIntSize(v.amount) +
+		bigIntSize(v.weighted)
+}
+
 // StartTime returns the start time
 func (v *Vote) StartTime() time.Time {
 	return v.startTime
@@ -0,0 +1,68 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"encoding/hex"
+	"math/big"
+)
+
+// VoterSummary summarizes the votes of a voter in a result
+type VoterSummary struct {
+	Voter []byte
+	// Votes are the votes of the voter in the canonical order
+	Votes               []*Vote
+	TotalAmount         *big.Int
+	TotalWeightedAmount *big.Int
+	// Delegates are the names of the delegates backed by the voter, in the order of their ranks
+	Delegates [][]byte
+}
+
+// VotesByVoter returns the votes of a voter in the canonical order
+func (r *ElectionResult) VotesByVoter(voter []byte) []*Vote {
+	r.votersOnce.Do(r.indexVoters)
+	return r.voters[hex.EncodeToString(voter)]
+}
+
+// VoterSummary returns the votes, the totals and the delegates of a voter, or nil if the voter has no
+// vote in the result
+func (r *ElectionResult) VoterSummary(voter []byte) *VoterSummary {
+	votes := r.VotesByVoter(voter)
+	if len(votes) == 0 {
+		return nil
+	}
+	summary := &VoterSummary{
+		Voter:               voter,
+		Votes:               votes,
+		TotalAmount:         big.NewInt(0),
+		TotalWeightedAmount: big.NewInt(0),
+		Delegates:           [][]byte{},
+	}
+	var last string
+	for _, v := range votes {
+		summary.TotalAmount.Add(summary.TotalAmount, v.amount)
+		summary.TotalWeightedAmount.Add(summary.TotalWeightedAmount, v.weighted)
+		// votes are grouped by delegates in the canonical order
+		if name := string(v.candidate); name != last {
+			summary.Delegates = append(summary.Delegates, v.Candidate())
+			last = name
+		}
+	}
+	return summary
+}
+
+func (r *ElectionResult) indexVoters() {
+	r.voters = map[string][]*Vote{}
+	for _, v := range r.Votes() {
+		voter := hex.EncodeToString(v.voter)
+		r.voters[voter] = append(r.voters[voter], v)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoterSummary(t *testing.T) {
+	require := require.New(t)
+	mintTime := time.Now().Add(-10 * time.Hour)
+	calculator := NewResultCalculator(
+		mintTime,
+		false,
+		mockVoteFilter(10),
+		mockCalcWeight,
+		mockCandidateFilter(0, 0),
+	)
+	require.NoError(calculator.AddCandidates(genTestCandidates()))
+	require.NoError(calculator.AddVotes(genTestVotes(mintTime, require)))
+	result, err := calculator.Calculate()
+	require.NoError(err)
+
+	require.Nil(result.VotesByVoter([]byte("nobody")))
+	require.Nil(result.VoterSummary([]byte("nobody")))
+	for _, vote := range result.Votes() {
+		voter := vote.Voter()
+		expected := []*Vote{}
+		amount := big.NewInt(0)
+		weighted := big.NewInt(0)
+		for _, v := range result.Votes() {
+			if bytes.Equal(voter, v.Voter()) {
+				expected = append(expected, v)
+				amount.Add(amount, v.Amount())
+				weighted.Add(weighted, v.WeightedAmount())
+			}
+		}
+		require.Equal(expected, result.VotesByVoter(voter))
+		summary := result.VoterSummary(voter)
+		require.NotNil(summary)
+		require.Equal(voter, summary.Voter)
+		require.Equal(expected, summary.Votes)
+		require.Equal(0, amount.Cmp(summary.TotalAmount))
+		require.Equal(0, weighted.Cmp(summary.TotalWeightedAmount))
+		rank := -1
+		for _, name := range summary.Delegates {
+			require.NotEmpty(result.VotesByDelegate(name))
+			for i, d := range result.Delegates() {
+				if bytes.Equal(d.Name(), name) {
+					require.True(i > rank)
+					rank = i
+				}
+			}
+		}
+	}
+	require.Empty(result.Summary().VotesByVoter(result.Votes()[0].Voter()))
+}
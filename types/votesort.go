@@ -0,0 +1,90 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"bytes"
+	"sort"
+	"time"
+)
+
+// VoteSortKey defines the key to sort votes by
+type VoteSortKey int
+
+const (
+	// CanonicalOrder keeps the canonical order of the votes of a result
+	CanonicalOrder VoteSortKey = iota
+	// ByAmount sorts votes by the staked amount
+	ByAmount
+	// ByWeightedAmount sorts votes by the weighted amount
+	ByWeightedAmount
+	// ByRemainingDuration sorts votes by the remaining duration at the mint time
+	ByRemainingDuration
+	// ByStartTime sorts votes by the start time
+	ByStartTime
+	// ByVoter sorts votes by the voter address
+	ByVoter
+)
+
+// SortVotes returns a copy of the votes sorted by key in ascending order, or in descending order if
+// descending is true. Votes of the same key keep their relative order, such that the result is
+// deterministic given the votes in the canonical order
+func SortVotes(votes []*Vote, key VoteSortKey, descending bool, mintTime time.Time) []*Vote {
+	sorted := make([]*Vote, len(votes))
+	copy(sorted, votes)
+	var compare func(a, b *Vote) int
+	switch key {
+	case ByAmount:
+		compare = func(a, b *Vote) int { return a.amount.Cmp(b.amount) }
+	case ByWeightedAmount:
+		compare = func(a, b *Vote) int { return a.weighted.Cmp(b.weighted) }
+	case ByRemainingDuration:
+		compare = func(a, b *Vote) int {
+			return compareDuration(a.RemainingTime(mintTime), b.RemainingTime(mintTime))
+		}
+	case ByStartTime:
+		compare = func(a, b *Vote) int { return compareTime(a.startTime, b.startTime) }
+	case ByVoter:
+		compare = func(a, b *Vote) int { return bytes.Compare(a.voter, b.voter) }
+	default:
+		return sorted
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if descending {
+			return compare(sorted[i], sorted[j]) > 0
+		}
+		return compare(sorted[i], sorted[j]) < 0
+	})
+
+	return sorted
+}
+
+func compareDuration(a, b time.Duration) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2019 IoTeX
+// This program is free software: you can redistribute it and/or modify it under the terms of the
+// GNU General Public License as published by the Free Software Foundation, either version 3 of
+// the License, or (at your option) any later version.
+// This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY;
+// without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See
+// the GNU General Public License for more details.
+// You should have received a copy of the GNU General Public License along with this program. If
+// not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortVotes(t *testing.T) {
+	require := require.New(t)
+	mintTime := time.Now().Add(-10 * time.Hour)
+	calculator := NewResultCalculator(
+		mintTime,
+		false,
+		mockVoteFilter(10),
+		mockCalcWeight,
+		mockCandidateFilter(0, 0),
+	)
+	require.NoError(calculator.AddCandidates(genTestCandidates()))
+	require.NoError(calculator.AddVotes(genTestVotes(mintTime, require)))
+	result, err := calculator.Calculate()
+	require.NoError(err)
+
+	votes := result.Votes()
+	require.True(len(votes) > 1)
+	t.Run("canonical-order", func(t *testing.T) {
+		i := 0
+		for _, delegate := range result.Delegates() {
+			for _, v := range result.VotesByDelegate(delegate.Name()) {
+				require.Equal(v, votes[i])
+				i++
+			}
+		}
+		require.Equal(len(votes), i)
+		for j := 0; j < 10; j++ {
+			require.Equal(votes, result.Votes())
+		}
+		require.Equal(votes, SortVotes(votes, CanonicalOrder, true, mintTime))
+	})
+	position := make(map[*Vote]int, len(votes))
+	for i, v := range votes {
+		position[v] = i
+	}
+	compares := map[VoteSortKey]func(a, b *Vote) int{
+		ByAmount:         func(a, b *Vote) int { return a.Amount().Cmp(b.Amount()) },
+		ByWeightedAmount: func(a, b *Vote) int { return a.WeightedAmount().Cmp(b.WeightedAmount()) },
+		ByRemainingDuration: func(a, b *Vote) int {
+			return big.NewInt(int64(a.RemainingTime(mintTime))).Cmp(big.NewInt(int64(b.RemainingTime(mintTime))))
+		},
+		ByStartTime: func(a, b *Vote) int {
+			return big.NewInt(a.StartTime().UnixNano()).Cmp(big.NewInt(b.StartTime().UnixNano()))
+		},
+		ByVoter: func(a, b *Vote) int { return bytes.Compare(a.Voter(), b.Voter()) },
+	}
+	for key, compare := range compares {
+		for _, descending := range []bool{false, true} {
+			sorted := SortVotes(votes, key, descending, mintTime)
+			require.Equal(len(votes), len(sorted))
+			for i := 1; i < len(sorted); i++ {
+				c := compare(sorted[i-1], sorted[i])
+				if descending {
+					c = -c
+				}
+				require.True(c <= 0, "key %d, descending %t", key, descending)
+				if c == 0 {
+					// ties keep the canonical order
+					require.True(position[sorted[i-1]] < position[sorted[i]])
+				}
+			}
+		}
+	}
+	// the input is not modified
+	require.Equal(result.Votes(), votes)
+}
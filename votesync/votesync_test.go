@@ -166,4 +166,10 @@ func (*mockCarrier) Votes(h uint64, pidx *big.Int, count uint8) (*big.Int, []*ty
 	return nil, nil, nil
 }
 
+func (*mockCarrier) BucketIndexes(uint64, *big.Int, uint32) (*big.Int, []*big.Int, error) {
+	return nil, nil, nil
+}
+
+func (*mockCarrier) Endpoint() string { return "" }
+
 func (*mockCarrier) Close() {}